@@ -0,0 +1,319 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/riclib/open-props-css/op/contrast"
+)
+
+var (
+	// commentPattern matches CSS comments
+	commentPattern = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+	// rulePattern matches innermost rules, so rules nested in @media blocks
+	// are found too
+	rulePattern = regexp.MustCompile(`([^{}]+)\{([^{}]*)\}`)
+
+	// statePattern matches the interaction states that inherit their
+	// foreground or background from the plain selector
+	statePattern = regexp.MustCompile(`:(hover|focus-visible|focus-within|focus|active|visited|disabled)\b`)
+)
+
+// pageBackgrounds are the surfaces text without its own background is shown on
+var pageBackgrounds = []string{"var(--background)", "var(--surface)"}
+
+// pageText is the foreground of rules that only set a background
+const pageText = "var(--text)"
+
+// rule is a CSS rule that sets a foreground or background color
+type rule struct {
+	selector   string
+	color      string
+	background string
+}
+
+// pairing is a foreground on a background in one theme, with the selectors
+// that use it
+type pairing struct {
+	theme      contrast.Theme
+	color      string
+	background string
+	selectors  []string
+	ratio      float64
+	lc         float64
+}
+
+// key identifies a pairing in the known failures file
+func (p *pairing) key() string {
+	return string(p.theme) + "\t" + p.color + "\t" + p.background
+}
+
+func main() {
+	var files, level, knownFile string
+	var failuresOnly bool
+
+	// Define command-line flags
+	flag.StringVar(&files, "css", "src/base.css,src/components.css,src/utilities.css", "Comma-separated stylesheets to audit")
+	flag.StringVar(&level, "level", "AA", "Minimum level for normal text: AA, AAA or AA-large")
+	flag.BoolVar(&failuresOnly, "failures", false, "Only report the pairings below the level")
+	flag.StringVar(&knownFile, "known", "src/contrast-known-failures.txt", "Known failures that do not fail the audit unless their ratio drops (empty = none)")
+
+	// Custom usage function
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Contrast audit for the Open Props CSS Framework\n\n")
+		fmt.Fprintf(os.Stderr, "Checks every text and background color pairing in the stylesheets against\n")
+		fmt.Fprintf(os.Stderr, "WCAG 2.x in the light and dark themes, using the token values compiled into\n")
+		fmt.Fprintf(os.Stderr, "the op package (run go generate ./op after changing src/tokens.css).\n")
+		fmt.Fprintf(os.Stderr, "Exits with status 1 if a pairing is below the level, unless it is listed in\n")
+		fmt.Fprintf(os.Stderr, "the known failures file at its current ratio or better.\n\n")
+		fmt.Fprintf(os.Stderr, "Usage: %s [options]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nExamples:\n")
+		fmt.Fprintf(os.Stderr, "  # Audit the framework stylesheets for AA\n")
+		fmt.Fprintf(os.Stderr, "  %s\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Only list what fails AAA\n")
+		fmt.Fprintf(os.Stderr, "  %s -level AAA -failures\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Audit an application stylesheet\n")
+		fmt.Fprintf(os.Stderr, "  %s -css static/app.css -known \"\"\n", os.Args[0])
+	}
+
+	flag.Parse()
+
+	minimum, ok := map[string]float64{"AA": contrast.AA, "AAA": contrast.AAA, "AA-large": contrast.AALarge}[level]
+	if !ok {
+		log.Fatalf("Unknown level %q (want AA, AAA or AA-large)", level)
+	}
+
+	var rules []rule
+	for _, file := range strings.Split(files, ",") {
+		css, err := os.ReadFile(strings.TrimSpace(file))
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", file, err)
+		}
+		rules = append(rules, parseRules(string(css))...)
+	}
+
+	known, err := readKnownFailures(knownFile)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", knownFile, err)
+	}
+
+	// A known failure only fails the audit when its contrast gets worse
+	pairings := audit(rules)
+	failures, accepted := 0, 0
+	for _, p := range pairings {
+		if p.ratio >= minimum {
+			continue
+		}
+		if ratio, ok := known[p.key()]; ok && math.Round(p.ratio*100)/100 >= ratio {
+			accepted++
+			continue
+		}
+		failures++
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "THEME\tTEXT\tBACKGROUND\tRATIO\tAPCA Lc\tLEVEL\tSELECTORS")
+	for _, p := range pairings {
+		if failuresOnly && p.ratio >= minimum {
+			continue
+		}
+		mark := ""
+		if ratio, ok := known[p.key()]; ok && p.ratio < minimum && math.Round(p.ratio*100)/100 >= ratio {
+			mark = "~ "
+		} else if p.ratio < minimum {
+			mark = "✗ "
+		}
+		fmt.Fprintf(w, "%s%s\t%s\t%s\t%.2f\t%.1f\t%s\t%s\n",
+			mark, p.theme, p.color, p.background, p.ratio, p.lc, contrast.Level(p.ratio), strings.Join(p.selectors, " | "))
+	}
+	w.Flush()
+
+	if failures > 0 {
+		fmt.Printf("\n✗ %d of %d pairings are below %s (%.1f:1)", failures, len(pairings), level, minimum)
+		if accepted > 0 {
+			fmt.Printf(", not counting %d known failures (~)", accepted)
+		}
+		fmt.Println()
+		os.Exit(1)
+	}
+	if accepted > 0 {
+		fmt.Printf("\n✓ All %d pairings meet %s (%.1f:1) except %d known failures (~) listed in %s\n", len(pairings), level, minimum, accepted, knownFile)
+		return
+	}
+	fmt.Printf("\n✓ All %d pairings meet %s (%.1f:1)\n", len(pairings), level, minimum)
+}
+
+// readKnownFailures reads the pairings accepted below the level, keyed by
+// theme, text and background, with the lowest ratio accepted for each. Each
+// line has those four fields separated by tabs; # starts a comment. A
+// missing file lists no failures.
+func readKnownFailures(path string) (map[string]float64, error) {
+	known := make(map[string]float64)
+	if path == "" {
+		return known, nil
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return known, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("line %d: want theme, text, background and ratio separated by tabs", n)
+		}
+		ratio, err := strconv.ParseFloat(fields[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid ratio %q", n, fields[3])
+		}
+		known[strings.Join(fields[:3], "\t")] = ratio
+	}
+	return known, scanner.Err()
+}
+
+// parseRules returns the rules of a stylesheet that set color or a
+// background color, skipping at-rules such as @font-face
+func parseRules(css string) []rule {
+	var rules []rule
+	css = commentPattern.ReplaceAllString(css, "")
+	for _, m := range rulePattern.FindAllStringSubmatch(css, -1) {
+		selector := strings.Join(strings.Fields(m[1]), " ")
+		if strings.HasPrefix(selector, "@") {
+			continue
+		}
+		r := rule{selector: selector}
+		for _, decl := range strings.Split(m[2], ";") {
+			property, value, ok := strings.Cut(decl, ":")
+			if !ok {
+				continue
+			}
+			value = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "!important"))
+			switch strings.TrimSpace(property) {
+			case "color":
+				r.color = value
+			case "background-color", "background":
+				r.background = value
+			}
+		}
+		if r.background == "transparent" || r.background == "none" {
+			r.background = ""
+		}
+		if r.color != "" || r.background != "" {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+// audit pairs each rule's foreground with its background in both themes.
+// A state such as :hover inherits what it does not set from the plain
+// selector; text without a background is checked on the page backgrounds
+// and a background without text against the page text.
+func audit(rules []rule) []*pairing {
+	bySelector := make(map[string]rule)
+	for _, r := range rules {
+		prev := bySelector[r.selector]
+		if r.color != "" {
+			prev.color = r.color
+		}
+		if r.background != "" {
+			prev.background = r.background
+		}
+		prev.selector = r.selector
+		bySelector[r.selector] = prev
+	}
+
+	seen := make(map[string]*pairing)
+	var pairings []*pairing
+	for _, r := range rules {
+		if base, ok := bySelector[statePattern.ReplaceAllString(r.selector, "")]; ok {
+			if r.color == "" {
+				r.color = base.color
+			}
+			if r.background == "" {
+				r.background = base.background
+			}
+		}
+		if r.color == "" {
+			r.color = pageText
+		}
+		backgrounds := pageBackgrounds
+		if r.background != "" {
+			backgrounds = []string{r.background}
+		}
+
+		for _, theme := range contrast.Themes {
+			for _, background := range backgrounds {
+				key := string(theme) + "\x00" + r.color + "\x00" + background
+				if p, ok := seen[key]; ok {
+					if !contains(p.selectors, r.selector) {
+						p.selectors = append(p.selectors, r.selector)
+					}
+					continue
+				}
+				fg, err := contrast.Resolve(r.color, theme)
+				if err != nil {
+					log.Printf("Skipping %s: %v", r.selector, err)
+					continue
+				}
+				bg, err := contrast.Resolve(background, theme)
+				if err != nil {
+					log.Printf("Skipping %s: %v", r.selector, err)
+					continue
+				}
+				p := &pairing{
+					theme:      theme,
+					color:      r.color,
+					background: background,
+					selectors:  []string{r.selector},
+					ratio:      contrast.Ratio(fg, bg),
+					lc:         contrast.APCA(fg, bg),
+				}
+				seen[key] = p
+				pairings = append(pairings, p)
+			}
+		}
+	}
+
+	// Lowest contrast first, light before dark
+	sort.SliceStable(pairings, func(i, j int) bool {
+		if pairings[i].ratio != pairings[j].ratio {
+			return pairings[i].ratio < pairings[j].ratio
+		}
+		return pairings[i].theme < pairings[j].theme
+	})
+	return pairings
+}
+
+// contains reports whether list has s
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/riclib/open-props-css/internal/cssbuild"
+)
+
+func main() {
+	var config cssbuild.Config
+	var outFile, readableFile string
+	var check, verbose bool
+
+	// Define command-line flags
+	flag.StringVar(&config.Entry, "src", "src/index.css", "Entry stylesheet")
+	flag.StringVar(&config.VendorDir, "vendor", "src/vendor", "Directory with vendored packages for bare @import paths")
+	flag.StringVar(&outFile, "out", "uicss/dashboard.css", "Output path for the minified CSS")
+	flag.StringVar(&readableFile, "readable", "uicss/dashboard.readable.css", "Output path for the readable CSS")
+	flag.BoolVar(&check, "check", false, "Verify that the outputs are up to date instead of writing them")
+	flag.BoolVar(&verbose, "verbose", false, "List the inlined source files")
+
+	// Custom usage function
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "CSS build for the Open Props CSS Framework\n\n")
+		fmt.Fprintf(os.Stderr, "Usage: %s [options]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nExamples:\n")
+		fmt.Fprintf(os.Stderr, "  # Rebuild uicss/dashboard.css and uicss/dashboard.readable.css\n")
+		fmt.Fprintf(os.Stderr, "  %s\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Fail if the checked-in CSS is stale (for CI)\n")
+		fmt.Fprintf(os.Stderr, "  %s -check\n", os.Args[0])
+	}
+
+	flag.Parse()
+
+	result, err := cssbuild.Build(config)
+	if err != nil {
+		log.Fatalf("Build failed: %v", err)
+	}
+
+	if verbose {
+		fmt.Printf("Inlined files:\n")
+		for _, file := range result.Files {
+			fmt.Printf("  - %s\n", file)
+		}
+	}
+
+	outputs := []struct {
+		path    string
+		content string
+	}{
+		{outFile, result.CSS},
+		{readableFile, result.ReadableCSS},
+	}
+
+	if check {
+		stale := false
+		for _, output := range outputs {
+			existing, err := os.ReadFile(output.path)
+			if err != nil || string(existing) != output.content {
+				fmt.Fprintf(os.Stderr, "%s is out of date\n", output.path)
+				stale = true
+			}
+		}
+		if stale {
+			fmt.Fprintf(os.Stderr, "\nRun 'go generate ./uicss' to rebuild the CSS\n")
+			os.Exit(1)
+		}
+		fmt.Printf("✓ CSS is up to date\n")
+		return
+	}
+
+	for _, output := range outputs {
+		if err := os.WriteFile(output.path, []byte(output.content), 0644); err != nil {
+			log.Fatalf("Failed to write %s: %v", output.path, err)
+		}
+		fmt.Printf("✓ Built %s\n", output.path)
+	}
+}
@@ -6,3 +6,7 @@ require (
 	github.com/a-h/templ v0.3.920
 	github.com/riclib/open-props-css v0.3.0
 )
+
+require github.com/andybalholm/brotli v1.2.0 // indirect
+
+replace github.com/riclib/open-props-css => ../..
@@ -5,6 +5,8 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/a-h/templ"
+	"github.com/riclib/open-props-css/op"
 	"github.com/riclib/open-props-css/uicss"
 )
 
@@ -15,23 +17,23 @@ func main() {
 
 	// Routes
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		HomePage().Render(r.Context(), w)
+		render(w, r, HomePage())
 	})
 
 	http.HandleFunc("/colors", func(w http.ResponseWriter, r *http.Request) {
-		ColorsPage().Render(r.Context(), w)
+		render(w, r, ColorsPage())
 	})
 
 	http.HandleFunc("/spacing", func(w http.ResponseWriter, r *http.Request) {
-		SpacingPage().Render(r.Context(), w)
+		render(w, r, SpacingPage())
 	})
 
 	http.HandleFunc("/animations", func(w http.ResponseWriter, r *http.Request) {
-		AnimationsPage().Render(r.Context(), w)
+		render(w, r, AnimationsPage())
 	})
 
 	http.HandleFunc("/icons", func(w http.ResponseWriter, r *http.Request) {
-		IconsPage().Render(r.Context(), w)
+		render(w, r, IconsPage())
 	})
 
 	fmt.Println("Op Package Demo running at http://localhost:8081")
@@ -42,4 +44,10 @@ func main() {
 	fmt.Println("- Icons: http://localhost:8081/icons")
 
 	log.Fatal(http.ListenAndServe(":8081", nil))
-}
\ No newline at end of file
+}
+
+// render renders a page with its own op.Sheet, which the layout emits as a
+// <style> block once the page has registered its classes
+func render(w http.ResponseWriter, r *http.Request, page templ.Component) {
+	page.Render(op.WithSheet(r.Context(), op.NewSheet()), w)
+}
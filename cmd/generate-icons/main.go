@@ -6,20 +6,30 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/riclib/open-props-css/internal/lucidegen"
 )
 
 func main() {
 	var config lucidegen.Config
+	var icons, scanDirs string
 
 	// Define command-line flags
 	flag.StringVar(&config.OutputDir, "out", "./icon", "Output directory for generated files")
 	flag.StringVar(&config.PackageName, "package", "icon", "Go package name")
+	flag.StringVar(&config.Source, "source", "", "Lucide checkout or icons directory, .tar.gz or .zip archive, or git URL (default: clone "+lucidegen.DefaultRepository+")")
+	flag.StringVar(&config.Version, "version", "", "Lucide tag, branch or commit to generate from (default: the locked version, or the latest)")
+	flag.StringVar(&config.LockFile, "lock", "", "Lockfile recording the version, commit and icon hashes (default: <out>/"+lucidegen.LockFileName+")")
+	flag.BoolVar(&config.Update, "update", false, "Accept a new version or changed icons and rewrite the lockfile")
+	flag.BoolVar(&config.AllowUnpinned, "allow-unpinned", false, "Write a lockfile even when the source has no version or commit")
 	flag.StringVar(&config.Prefix, "prefix", "", "Prefix for constant names")
 	flag.BoolVar(&config.DryRun, "dry-run", false, "Preview what would be generated without creating files")
 	flag.BoolVar(&config.Verbose, "verbose", true, "Enable verbose logging")
 	flag.BoolVar(&config.IncludeSearch, "search", true, "Include search functionality")
+	flag.StringVar(&icons, "icons", "", "Comma-separated icon names or constants to include (default: all)")
+	flag.StringVar(&config.AllowlistFile, "allowlist", "", "File listing the icons to include, one per line")
+	flag.StringVar(&scanDirs, "scan", "", "Comma-separated directories whose .go and .templ files are scanned for <package>.Icon* references to include")
 
 	// Custom usage function
 	flag.Usage = func() {
@@ -32,12 +42,26 @@ func main() {
 		fmt.Fprintf(os.Stderr, "  %s\n\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "  # Preview what would be generated\n")
 		fmt.Fprintf(os.Stderr, "  %s -dry-run\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Generate offline from a local checkout or a release archive\n")
+		fmt.Fprintf(os.Stderr, "  %s -source ../lucide\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -source lucide-0.460.0.tar.gz\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Pin a Lucide release; later runs regenerate it as recorded in %s\n", lucidegen.LockFileName)
+		fmt.Fprintf(os.Stderr, "  %s -version 0.460.0\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Move to another Lucide release and update the lockfile\n")
+		fmt.Fprintf(os.Stderr, "  %s -version 0.460.0 -update\n\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "  # Generate to custom directory\n")
-		fmt.Fprintf(os.Stderr, "  %s -out ./myicons -package myicons\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -out ./myicons -package myicons\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Generate only the icons a service renders, e.g. from //go:generate\n")
+		fmt.Fprintf(os.Stderr, "  %s -out ./internal/icons -package icons -scan . -search=false\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Generate the icons listed in a file\n")
+		fmt.Fprintf(os.Stderr, "  %s -out ./internal/icons -package icons -allowlist icons.txt\n", os.Args[0])
 	}
 
 	flag.Parse()
 
+	config.Icons = splitList(icons)
+	config.ScanDirs = splitList(scanDirs)
+
 	// Make output directory absolute
 	absOut, err := filepath.Abs(config.OutputDir)
 	if err != nil {
@@ -60,9 +84,23 @@ func main() {
 			fmt.Printf("  - %s\n", relPath)
 		}
 		fmt.Printf("\nCategories: %v\n", result.Categories)
+		if result.Version != "" || result.Commit != "" {
+			fmt.Printf("\n✓ Lucide %s %s\n", result.Version, result.Commit)
+		}
 		fmt.Printf("\nNext steps:\n")
 		fmt.Printf("  1. Run 'templ generate' in the %s directory\n", config.OutputDir)
 		fmt.Printf("  2. Import the package: import \"%s\"\n", config.PackageName)
 		fmt.Printf("  3. Use icons: @%s.Icon(%s.IconHome, templ.Attributes{})\n", config.PackageName, config.PackageName)
 	}
-}
\ No newline at end of file
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
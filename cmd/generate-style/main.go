@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+//go:embed properties.txt
+var defaultProperties string
+
+// valueTypes are the op types a property method may accept, with the
+// expression converting value to a string
+var valueTypes = map[string]string{
+	"string":          "value",
+	"Length":          "string(value)",
+	"ColorValue":      "string(value)",
+	"ShadowValue":     "string(value)",
+	"GradientValue":   "string(value)",
+	"BackgroundValue": "stringOf(value)",
+	"Easing":          "string(value)",
+	"Duration":        "string(value)",
+	"AnimationValue":  "string(value)",
+	"FontWeight":      "string(value)",
+	"FontFamily":      "string(value)",
+	"Number":          "string(value)",
+}
+
+var propertyPattern = regexp.MustCompile(`^[a-z]+(-[a-z]+)*$`)
+
+// Property is a CSS property with its generated method
+type Property struct {
+	Name   string // CSS property, e.g. "grid-template-columns"
+	Method string // Go method, e.g. "GridTemplateColumns"
+	Type   string // Accepted value type
+	Value  string // Expression converting value to a string
+}
+
+// Template for the Style property methods
+const styleTemplate = `// Code generated by cmd/generate-style from cmd/generate-style/properties.txt. DO NOT EDIT.
+
+package {{.PackageName}}
+
+// knownProperties are the properties accepted by Style.Custom, besides custom
+// properties and vendor-prefixed names
+var knownProperties = map[string]bool{
+{{- range .Properties}}
+	{{printf "%q" .Name}}: true,
+{{- end}}
+}
+{{range .Properties}}
+// {{.Method}} adds a {{.Name}} property
+func (s *Style) {{.Method}}(value {{.Type}}) *Style {
+	return s.add({{printf "%q" .Name}}, {{.Value}})
+}
+{{end}}`
+
+func main() {
+	var listFile, outFile, packageName string
+
+	// Define command-line flags
+	flag.StringVar(&listFile, "properties", "", "Property list (default: the embedded properties.txt)")
+	flag.StringVar(&outFile, "out", "op/style_gen.go", "Output Go file")
+	flag.StringVar(&packageName, "package", "op", "Go package name")
+	flag.Parse()
+
+	list := defaultProperties
+	if listFile != "" {
+		data, err := os.ReadFile(listFile)
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", listFile, err)
+		}
+		list = string(data)
+	}
+
+	properties, err := parseProperties(list)
+	if err != nil {
+		log.Fatalf("Invalid property list: %v", err)
+	}
+
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New("style").Parse(styleTemplate))
+	err = tmpl.Execute(&buf, map[string]any{
+		"PackageName": packageName,
+		"Properties":  properties,
+	})
+	if err != nil {
+		log.Fatalf("Failed to render template: %v", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format generated code: %v", err)
+	}
+	if err := os.WriteFile(outFile, src, 0644); err != nil {
+		log.Fatalf("Failed to write %s: %v", outFile, err)
+	}
+	fmt.Printf("✓ Generated %s with %d properties\n", outFile, len(properties))
+}
+
+// parseProperties reads "property [Type]" lines, skipping blanks and comments
+func parseProperties(list string) ([]Property, error) {
+	var properties []Property
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(strings.NewReader(list))
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		p := Property{Name: fields[0], Type: "string"}
+		if len(fields) > 1 {
+			p.Type = fields[1]
+		}
+		switch {
+		case len(fields) > 2:
+			return nil, fmt.Errorf("line %d: expected \"property [Type]\"", line)
+		case !propertyPattern.MatchString(p.Name):
+			return nil, fmt.Errorf("line %d: invalid property %q", line, p.Name)
+		case valueTypes[p.Type] == "":
+			return nil, fmt.Errorf("line %d: unknown type %q", line, p.Type)
+		case seen[p.Name]:
+			return nil, fmt.Errorf("line %d: duplicate property %q", line, p.Name)
+		}
+		seen[p.Name] = true
+		p.Value = valueTypes[p.Type]
+
+		for _, part := range strings.Split(p.Name, "-") {
+			p.Method += strings.ToUpper(part[:1]) + part[1:]
+		}
+		properties = append(properties, p)
+	}
+	return properties, scanner.Err()
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/riclib/open-props-css/internal/designtokens"
+)
+
+func main() {
+	var config designtokens.Config
+
+	// Define command-line flags
+	flag.StringVar(&config.CSS.Entry, "src", "src/index.css", "Entry stylesheet")
+	flag.StringVar(&config.CSS.VendorDir, "vendor", "src/vendor", "Directory with vendored packages for bare @import paths")
+	flag.StringVar(&config.SemanticFile, "semantic", "src/tokens.css", "Stylesheet declaring the project's semantic tokens")
+	flag.StringVar(&config.TableFile, "out", "op/tokens_gen.go", "Output Go file for the resolved token table")
+	flag.StringVar(&config.ColorsFile, "colors", "op/colors_gen.go", "Output Go file for the op.Color theme accessors")
+	flag.StringVar(&config.PackageName, "package", "op", "Go package name")
+	flag.Parse()
+
+	result, err := designtokens.Generate(config)
+	if err != nil {
+		log.Fatalf("Generation failed: %v", err)
+	}
+	fmt.Printf("✓ Generated %s with %d tokens\n", config.TableFile, result.Tokens)
+	fmt.Printf("✓ Generated %s with %s\n", config.ColorsFile, strings.Join(result.Colors, ", "))
+}
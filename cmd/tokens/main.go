@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/riclib/open-props-css/internal/designtokens"
+	"github.com/riclib/open-props-css/op"
+)
+
+func main() {
+	var format, outFile string
+	var importFile, group, opDir string
+	var config designtokens.Config
+
+	formats := make([]string, len(op.TokenFormats))
+	for i, f := range op.TokenFormats {
+		formats[i] = string(f)
+	}
+
+	// Define command-line flags
+	flag.StringVar(&format, "format", string(op.FormatDTCG), "Output format: "+strings.Join(formats, ", "))
+	flag.StringVar(&outFile, "out", "", "Output file (default: stdout)")
+	flag.StringVar(&importFile, "import", "", "Import semantic tokens from a DTCG JSON file instead of exporting")
+	flag.StringVar(&group, "group", "semantic", "DTCG group holding the semantic tokens (empty = whole file)")
+	flag.StringVar(&config.SemanticFile, "css", "src/tokens.css", "Semantic token stylesheet rewritten by -import")
+	flag.StringVar(&config.CSS.Entry, "src", "src/index.css", "Entry stylesheet")
+	flag.StringVar(&config.CSS.VendorDir, "vendor", "src/vendor", "Directory with vendored packages for bare @import paths")
+	flag.StringVar(&opDir, "op", "op", "Directory of the op package regenerated by -import")
+
+	// Custom usage function
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Design token export for the Open Props CSS Framework\n\n")
+		fmt.Fprintf(os.Stderr, "Usage: %s [options]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nExamples:\n")
+		fmt.Fprintf(os.Stderr, "  # W3C Design Tokens (DTCG) JSON on stdout\n")
+		fmt.Fprintf(os.Stderr, "  %s\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Tokens Studio for Figma\n")
+		fmt.Fprintf(os.Stderr, "  %s -format tokens-studio -out tokens.json\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Style Dictionary source file\n")
+		fmt.Fprintf(os.Stderr, "  %s -format style-dictionary -out tokens/open-props.json\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  # Update src/tokens.css and the op.Color accessors from a design export\n")
+		fmt.Fprintf(os.Stderr, "  %s -import figma-tokens.json\n", os.Args[0])
+	}
+
+	flag.Parse()
+
+	if importFile != "" {
+		config.TableFile = filepath.Join(opDir, "tokens_gen.go")
+		config.ColorsFile = filepath.Join(opDir, "colors_gen.go")
+		config.PackageName = "op"
+		importTokens(importFile, group, config)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := op.ExportTokens(&buf, op.TokenFormat(format)); err != nil {
+		log.Fatalf("Export failed: %v", err)
+	}
+
+	if outFile == "" {
+		os.Stdout.Write(buf.Bytes())
+		return
+	}
+	if err := os.WriteFile(outFile, buf.Bytes(), 0644); err != nil {
+		log.Fatalf("Failed to write %s: %v", outFile, err)
+	}
+	fmt.Printf("✓ Exported %s tokens to %s\n", format, outFile)
+}
+
+// importTokens rewrites the semantic token stylesheet from a DTCG file and
+// regenerates the op token table and accessors from it
+func importTokens(file, group string, config designtokens.Config) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", file, err)
+	}
+	tokens, err := designtokens.ParseDTCG(data, group)
+	if err != nil {
+		log.Fatalf("Failed to parse %s: %v", file, err)
+	}
+	if len(tokens) == 0 {
+		log.Fatalf("No tokens found in group %q of %s", group, file)
+	}
+
+	current, err := os.ReadFile(config.SemanticFile)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", config.SemanticFile, err)
+	}
+	css := designtokens.UpdateStylesheet(string(current), tokens)
+	if err := os.WriteFile(config.SemanticFile, []byte(css), 0644); err != nil {
+		log.Fatalf("Failed to write %s: %v", config.SemanticFile, err)
+	}
+	fmt.Printf("✓ Imported %d tokens into %s\n", len(tokens), config.SemanticFile)
+
+	result, err := designtokens.Generate(config)
+	if err != nil {
+		log.Fatalf("Generation failed: %v", err)
+	}
+	fmt.Printf("✓ Regenerated %s and %s (%s)\n", config.TableFile, config.ColorsFile, strings.Join(result.Colors, ", "))
+	fmt.Printf("\nRun 'go generate ./uicss' to rebuild dashboard.css\n")
+}
@@ -2,4 +2,7 @@ module github.com/riclib/open-props-css
 
 go 1.24.4
 
-require github.com/a-h/templ v0.3.920
+require (
+	github.com/a-h/templ v0.3.920
+	github.com/andybalholm/brotli v1.2.0
+)
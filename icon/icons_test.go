@@ -3,6 +3,7 @@ package icon
 import (
 	"bytes"
 	"context"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -186,4 +187,159 @@ func TestMergeClasses(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestSprite(t *testing.T) {
+	sprite := NewSprite(IconHouse)
+	ctx := WithSprite(context.Background(), sprite)
+
+	buf := &bytes.Buffer{}
+	if err := Use(IconTrash2, templ.Attributes{"class": "icon-sm"}).Render(ctx, buf); err != nil {
+		t.Fatalf("Failed to render component: %v", err)
+	}
+	expected := `<span class="icon icon-sm"><svg fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><use href="#icon-trash-2"></use></svg></span>`
+	if buf.String() != expected {
+		t.Errorf("Use() = %s, want %s", buf.String(), expected)
+	}
+
+	// Unknown icons render nothing and are not tracked
+	buf.Reset()
+	if err := Use("no-such-icon", nil).Render(ctx, buf); err != nil || buf.Len() != 0 {
+		t.Errorf("Use(unknown) = %q, %v, want empty", buf.String(), err)
+	}
+
+	names := sprite.Names()
+	if len(names) != 2 || names[0] != IconHouse || names[1] != IconTrash2 {
+		t.Errorf("Names() = %v, want [house trash-2]", names)
+	}
+	if DefaultSprite.Len() != 0 {
+		t.Errorf("DefaultSprite.Len() = %d, want 0 with a sprite in the context", DefaultSprite.Len())
+	}
+
+	buf.Reset()
+	if err := sprite.Sheet().Render(ctx, buf); err != nil {
+		t.Fatalf("Failed to render sheet: %v", err)
+	}
+	sheet := buf.String()
+	for _, want := range []string{`style="display:none"`, `<symbol id="icon-house" viewBox="0 0 24 24"><path`, `<symbol id="icon-trash-2" viewBox="0 0 24 24">`} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("Sheet() missing %q in:\n%s", want, sheet)
+		}
+	}
+	if strings.Count(sheet, "<symbol") != 2 || strings.Contains(sheet, "stroke=") {
+		t.Errorf("Sheet() should hold two symbols without presentation attributes:\n%s", sheet)
+	}
+
+	// Served sheets are referenced by URL
+	sprite.SetURL("/static/icons.svg")
+	buf.Reset()
+	if err := sprite.Use(IconHouse, nil).Render(ctx, buf); err != nil {
+		t.Fatalf("Failed to render component: %v", err)
+	}
+	if !strings.Contains(buf.String(), `<use href="/static/icons.svg#icon-house">`) {
+		t.Errorf("Use() with URL = %s", buf.String())
+	}
+
+	rec := httptest.NewRecorder()
+	sprite.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/static/icons.svg", nil))
+	if ct := rec.Header().Get("Content-Type"); ct != "image/svg+xml" {
+		t.Errorf("Content-Type = %q, want image/svg+xml", ct)
+	}
+	if body := rec.Body.String(); !strings.HasPrefix(body, `<svg xmlns="http://www.w3.org/2000/svg"><symbol id="icon-house"`) {
+		t.Errorf("Handler() body = %s", body)
+	}
+	etag := rec.Header().Get("ETag")
+	req := httptest.NewRequest("GET", "/static/icons.svg", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	sprite.Handler().ServeHTTP(rec, req)
+	if rec.Code != 304 {
+		t.Errorf("Handler() with matching ETag = %d, want 304", rec.Code)
+	}
+}
+
+// benchmarkIcons is a spread of names across the registry
+var benchmarkIcons = []IconName{IconAArrowDown, IconChevronRight, IconHouse, IconPencil, IconTrash2, IconZoomOut}
+
+func BenchmarkIcon(b *testing.B) {
+	ctx := context.Background()
+	buf := &bytes.Buffer{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		if err := Icon(benchmarkIcons[i%len(benchmarkIcons)]).Render(ctx, buf); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkIconWithAttrs(b *testing.B) {
+	ctx := context.Background()
+	buf := &bytes.Buffer{}
+	attrs := templ.Attributes{"class": "icon-sm", "aria-hidden": "true"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		if err := IconWithAttrs(benchmarkIcons[i%len(benchmarkIcons)], attrs).Render(ctx, buf); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkIconExists(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		IconExists(string(benchmarkIcons[i%len(benchmarkIcons)]))
+	}
+}
+
+// TestRegistry checks that the registry table renders exactly like the
+// generated icon components
+func TestRegistry(t *testing.T) {
+	attrs := templ.Attributes{"class": "icon-sm", "id": "home"}
+	tests := []struct {
+		registry  templ.Component
+		component templ.Component
+	}{
+		{Icon(IconHouse), House()},
+		{IconWithAttrs(IconHouse, attrs), HouseWithAttrs(attrs)},
+		{IconWithAttrs(IconHouse, nil), HouseWithAttrs(nil)},
+		{IconSVG(IconTrash2), Trash2SVG()},
+		{IconSVGWithAttrs(IconTrash2, attrs), Trash2SVGWithAttrs(attrs)},
+		{IconSVG(IconAmbulance), AmbulanceSVG()},
+	}
+	for _, tt := range tests {
+		var got, want bytes.Buffer
+		if err := tt.registry.Render(context.Background(), &got); err != nil {
+			t.Fatalf("Failed to render registry icon: %v", err)
+		}
+		if err := tt.component.Render(context.Background(), &want); err != nil {
+			t.Fatalf("Failed to render icon component: %v", err)
+		}
+		if got.String() != want.String() {
+			t.Errorf("Registry output differs from the component:\n got %s\nwant %s", got.String(), want.String())
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := Icon("no-such-icon").Render(context.Background(), &buf); err != nil || buf.Len() != 0 {
+		t.Errorf("Icon(unknown) = %q, %v, want empty", buf.String(), err)
+	}
+
+	all := AllIcons()
+	if len(all) != IconCount() {
+		t.Errorf("len(AllIcons()) = %d, want IconCount() = %d", len(all), IconCount())
+	}
+	for i, name := range all {
+		if i > 0 && all[i-1] >= name {
+			t.Errorf("AllIcons() not sorted at %q", name)
+		}
+		if !IconExists(string(name)) {
+			t.Errorf("IconExists(%q) = false", name)
+		}
+	}
+	if name, ok := IconByName("house"); !ok || name != IconHouse {
+		t.Errorf("IconByName(\"house\") = %q, %v", name, ok)
+	}
+	if IconExists("no-such-icon") {
+		t.Error("IconExists(\"no-such-icon\") = true")
+	}
+}
@@ -0,0 +1,110 @@
+package cssbuild
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// calcOperand matches a number with an optional unit at the start of a calc() expression
+var calcOperand = regexp.MustCompile(`^\s*([-+]?(?:\d+\.?\d*|\.\d+)(?:e[-+]?\d+)?)([a-z%]*)\s*`)
+
+// dimension is a number with a CSS unit, "" for plain numbers
+type dimension struct {
+	value float64
+	unit  string
+}
+
+// evalCalc replaces calc() expressions of constant numbers, such as
+// calc(1% + 9%), with their result. Expressions mixing units or referring to
+// the viewport or the element, such as calc(100vw - 100%), are left as they
+// are, since their value is only known in the browser.
+func evalCalc(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); {
+		if !strings.HasPrefix(value[i:], "calc(") || (i > 0 && isIdentChar(value[i-1])) {
+			b.WriteByte(value[i])
+			i++
+			continue
+		}
+		end := matchingParen(value, i+len("calc"))
+		if end < 0 {
+			b.WriteString(value[i:])
+			break
+		}
+		inner := evalCalc(value[i+len("calc(") : end])
+		if d, ok := evalExpression(inner); ok {
+			b.WriteString(formatDimension(d))
+		} else {
+			b.WriteString("calc(" + inner + ")")
+		}
+		i = end + 1
+	}
+	return b.String()
+}
+
+// evalExpression evaluates a sequence of operands joined by + - * /,
+// multiplying and dividing before adding and subtracting
+func evalExpression(expr string) (dimension, bool) {
+	var terms []dimension // Summands, with subtraction folded into their sign
+	var op byte = '+'
+	for {
+		m := calcOperand.FindStringSubmatch(expr)
+		if m == nil {
+			return dimension{}, false
+		}
+		expr = expr[len(m[0]):]
+		n, err := strconv.ParseFloat(m[1], 64)
+		if err != nil {
+			return dimension{}, false
+		}
+		d := dimension{value: n, unit: m[2]}
+
+		switch op {
+		case '+', '-':
+			if op == '-' {
+				d.value = -d.value
+			}
+			terms = append(terms, d)
+		case '*':
+			last := &terms[len(terms)-1]
+			if last.unit != "" && d.unit != "" {
+				return dimension{}, false
+			}
+			last.value *= d.value
+			last.unit += d.unit
+		case '/':
+			last := &terms[len(terms)-1]
+			if d.unit != "" || d.value == 0 {
+				return dimension{}, false
+			}
+			last.value /= d.value
+		}
+
+		if expr == "" {
+			break
+		}
+		op = expr[0]
+		if !strings.ContainsRune("+-*/", rune(op)) {
+			return dimension{}, false
+		}
+		expr = expr[1:]
+	}
+
+	sum := terms[0]
+	for _, d := range terms[1:] {
+		if d.unit != sum.unit {
+			return dimension{}, false
+		}
+		sum.value += d.value
+	}
+	return sum, true
+}
+
+// formatDimension writes a dimension the way a minifier would, without
+// floating point noise such as 0.30000000000000004
+func formatDimension(d dimension) string {
+	n := math.Round(d.value*1e6) / 1e6
+	return strconv.FormatFloat(n, 'f', -1, 64) + d.unit
+}
@@ -0,0 +1,162 @@
+package cssbuild
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMinify(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"comments and whitespace", "/* c */\na {\n  color: red;\n}\n", "a{color:red}\n"},
+		{"commas", "a, b { color: rgba(0, 0, 0, 0.1); }", "a,b{color:rgba(0,0,0,0.1)}\n"},
+		{"empty custom property", ":root { --x: ; --y: 1 }", ":root{--x: ;--y:1}\n"},
+		{"strings", `a::before { content: "a ;  { b"; }`, `a::before{content:"a ;  { b"}` + "\n"},
+		{"descendant pseudo", "a :hover { color: red }", "a :hover{color:red}\n"},
+		{"media query", "@media (max-width: 768px) { a { b: c } }", "@media (max-width: 768px){a{b:c}}\n"},
+		{"license comment", "/*! keep */a{b:c}", "/*! keep */a{b:c}\n"},
+	}
+
+	for _, tt := range tests {
+		if result := Minify(tt.input); result != tt.expected {
+			t.Errorf("%s: Minify() = %q, want %q", tt.name, result, tt.expected)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	input := "/* Buttons */\nbutton{color:red;padding:0 1px}@media (min-width: 1px){a{b:c}}"
+	expected := `/* Buttons */
+button {
+  color: red;
+  padding: 0 1px;
+}
+
+@media (min-width: 1px) {
+  a {
+    b: c;
+  }
+}
+`
+	if result := Format(input); result != expected {
+		t.Errorf("Format() = %q, want %q", result, expected)
+	}
+}
+
+func TestBuildImports(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"index.css":        "@import \"a.css\";\n@import url(pkg/b.css);\n@import \"a.css\";\nbody{margin:0}\n",
+		"a.css":            "a{color:red}\n",
+		"vendor/pkg/b.css": "b{color:blue}\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := Build(Config{Entry: filepath.Join(dir, "index.css"), VendorDir: filepath.Join(dir, "vendor")})
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if expected := "a{color:red}b{color:blue}body{margin:0}\n"; result.CSS != expected {
+		t.Errorf("Build().CSS = %q, want %q", result.CSS, expected)
+	}
+}
+
+// TestCheckedInCSS fails when uicss/dashboard.css is stale, i.e. src/ was
+// edited without running go generate ./uicss
+func TestCheckedInCSS(t *testing.T) {
+	result, err := Build(Config{Entry: "../../src/index.css", VendorDir: "../../src/vendor"})
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	outputs := map[string]string{
+		"../../uicss/dashboard.css":          result.CSS,
+		"../../uicss/dashboard.readable.css": result.ReadableCSS,
+	}
+	for path, expected := range outputs {
+		existing, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(existing) != expected {
+			t.Errorf("%s is out of date; run go generate ./uicss", path)
+		}
+	}
+}
+
+func TestExtractTokens(t *testing.T) {
+	css := `:where(html){--gray-9:#212529;--gap:var(--size-2);--size-2:.5rem}
+:root{--text:light-dark(var(--gray-9),#fff);--ring:0 0 0 var(--gap,1px) var(--missing,red)}
+@media (prefers-color-scheme:dark){:where(html){--gap:1rem}}
+@supports (color:oklch(0 0 0)){:root{--gray-9:oklch(0.2 0 0)}}
+.dark{--text:#eee}`
+
+	tokens := ExtractTokens(css)
+	tests := []struct {
+		name  string
+		light string
+		dark  string
+	}{
+		{"gap", ".5rem", "1rem"},
+		{"gray-9", "#212529", "#212529"},
+		{"text", "#212529", "#eee"},
+		{"ring", "0 0 0 .5rem red", "0 0 0 1rem red"},
+	}
+
+	for _, tt := range tests {
+		if got := tokens.Light[tt.name]; got != tt.light {
+			t.Errorf("Light[%q] = %q, want %q", tt.name, got, tt.light)
+		}
+		if got := tokens.Dark[tt.name]; got != tt.dark {
+			t.Errorf("Dark[%q] = %q, want %q", tt.name, got, tt.dark)
+		}
+	}
+
+	// --gap is redeclared for the dark theme, so it is not an alias
+	css += "\n:root{--container-padding:var(--size-2);--accent:var(--gray-9,#000)}"
+	if aliases := ExtractTokens(css).Aliases; !reflect.DeepEqual(aliases, map[string]string{"container-padding": "size-2"}) {
+		t.Errorf("Aliases = %v, want container-padding: size-2", aliases)
+	}
+
+	// postcss helpers and empty properties are not tokens
+	tokens = ExtractTokens(css + "\n:root{--csstools-color-scheme--light:initial;--gradient-space: }")
+	for _, name := range []string{"csstools-color-scheme--light", "gradient-space"} {
+		_, light := tokens.Light[name]
+		_, dark := tokens.Dark[name]
+		if light || dark {
+			t.Errorf("ExtractTokens() kept --%s", name)
+		}
+	}
+}
+
+func TestEvalCalc(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"hsl(220 3% 15%/calc(1% + 9%))", "hsl(220 3% 15%/10%)"},
+		{"calc(0.1 + 0.2)", "0.3"},
+		{"calc(2 * 3px - 1px)", "5px"},
+		{"calc(10px / 4)", "2.5px"},
+		{"calc(calc(1rem + 1rem) * 2)", "4rem"},
+		{"clamp(0px,calc(100vw - 100%) * 1e5,2px)", "clamp(0px,calc(100vw - 100%) * 1e5,2px)"},
+		{"calc(1rem + 2px)", "calc(1rem + 2px)"},
+		{"calc(var(--x) + 1px)", "calc(var(--x) + 1px)"},
+	}
+	for _, tt := range tests {
+		if got := evalCalc(tt.value); got != tt.want {
+			t.Errorf("evalCalc(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
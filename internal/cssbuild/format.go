@@ -0,0 +1,276 @@
+package cssbuild
+
+import "strings"
+
+// tokenKind identifies the structural role of a token
+type tokenKind int
+
+const (
+	tokText    tokenKind = iota // selector, at-rule prelude or declaration
+	tokOpen                     // {
+	tokClose                    // }
+	tokSemi                     // ;
+	tokComment                  // /* ... */
+)
+
+// token is a structural piece of a stylesheet
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// scan splits css into structural tokens. Whitespace outside strings is
+// collapsed to single spaces and trimmed at token boundaries. Braces and
+// semicolons inside strings or parentheses (e.g. data URLs) stay in the text.
+func scan(css string) []token {
+	var tokens []token
+	var text strings.Builder
+	pendingSpace := false
+	depth := 0
+
+	flush := func() {
+		s := text.String()
+		text.Reset()
+		// A custom property may have an empty value ("--x: ;"), which needs the space
+		if pendingSpace && strings.HasSuffix(s, ":") {
+			s += " "
+		}
+		pendingSpace = false
+		if s != "" {
+			tokens = append(tokens, token{kind: tokText, text: s})
+		}
+	}
+	write := func(s string) {
+		if pendingSpace && text.Len() > 0 {
+			text.WriteByte(' ')
+		}
+		pendingSpace = false
+		text.WriteString(s)
+	}
+
+	for i := 0; i < len(css); i++ {
+		c := css[i]
+		switch {
+		case c == '/' && i+1 < len(css) && css[i+1] == '*':
+			end := strings.Index(css[i+2:], "*/")
+			if end < 0 {
+				end = len(css) - i - 2
+			}
+			comment := css[i : i+2+end]
+			i += 2 + end + 1
+			if depth > 0 || text.Len() > 0 {
+				// Comments inside a selector or value are dropped
+				pendingSpace = true
+				continue
+			}
+			flush()
+			tokens = append(tokens, token{kind: tokComment, text: comment + "*/"})
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(css) && css[j] != c {
+				if css[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(css) {
+				j = len(css) - 1
+			}
+			write(css[i : j+1])
+			i = j
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			pendingSpace = true
+		case c == '(':
+			depth++
+			write("(")
+		case c == ')':
+			if depth > 0 {
+				depth--
+			}
+			write(")")
+		case depth == 0 && c == '{':
+			flush()
+			tokens = append(tokens, token{kind: tokOpen})
+		case depth == 0 && c == '}':
+			flush()
+			tokens = append(tokens, token{kind: tokClose})
+		case depth == 0 && c == ';':
+			flush()
+			tokens = append(tokens, token{kind: tokSemi})
+		default:
+			write(string(c))
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// Minify removes comments and insignificant whitespace from css
+func Minify(css string) string {
+	tokens := scan(css)
+	var b strings.Builder
+	depth := 0
+	for i, t := range tokens {
+		switch t.kind {
+		case tokText:
+			text := compact(t.text)
+			if depth > 0 && (i+1 == len(tokens) || tokens[i+1].kind != tokOpen) {
+				text = compactDeclaration(text)
+			}
+			b.WriteString(text)
+		case tokOpen:
+			depth++
+			b.WriteByte('{')
+		case tokClose:
+			if depth > 0 {
+				depth--
+			}
+			b.WriteByte('}')
+		case tokSemi:
+			// The last semicolon in a block is optional
+			if i+1 < len(tokens) && tokens[i+1].kind == tokClose {
+				continue
+			}
+			b.WriteByte(';')
+		case tokComment:
+			// Preserve license comments
+			if strings.HasPrefix(t.text, "/*!") {
+				b.WriteString(t.text)
+			}
+		}
+	}
+	b.WriteByte('\n')
+	return b.String()
+}
+
+// compact removes spaces around commas and inside parentheses, outside strings
+func compact(s string) string {
+	var b strings.Builder
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if quote != 0 {
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'':
+			quote = c
+		case ' ':
+			out := b.String()
+			if i+1 < len(s) && (s[i+1] == ',' || s[i+1] == ')') {
+				continue
+			}
+			if len(out) > 0 && (out[len(out)-1] == ',' || out[len(out)-1] == '(') {
+				continue
+			}
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// compactDeclaration removes the space after the colon of a declaration,
+// keeping it for empty custom property values ("--x: ")
+func compactDeclaration(decl string) string {
+	formatted := formatDeclaration(decl)
+	if name, value, ok := strings.Cut(formatted, ": "); ok && value != "" && !strings.HasPrefix(decl, "@") {
+		return name + ":" + value
+	}
+	return decl
+}
+
+// Format pretty-prints css with one declaration per line and two-space
+// indentation, keeping comments
+func Format(css string) string {
+	tokens := scan(css)
+	var b strings.Builder
+	depth := 0
+
+	indent := func() {
+		b.WriteString(strings.Repeat("  ", depth))
+	}
+	blankLine := func() {
+		if out := b.String(); len(out) > 0 && !strings.HasSuffix(out, "\n\n") {
+			b.WriteByte('\n')
+		}
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		next := tokenKind(-1)
+		if i+1 < len(tokens) {
+			next = tokens[i+1].kind
+		}
+
+		switch t.kind {
+		case tokComment:
+			if depth == 0 {
+				blankLine()
+			}
+			indent()
+			b.WriteString(t.text)
+			b.WriteByte('\n')
+		case tokText:
+			indent()
+			if next == tokOpen {
+				// Selector or at-rule prelude
+				b.WriteString(t.text)
+				b.WriteString(" {\n")
+				depth++
+				i++
+				continue
+			}
+			b.WriteString(formatDeclaration(t.text))
+			b.WriteString(";\n")
+			if next == tokSemi {
+				i++
+			}
+		case tokOpen:
+			indent()
+			b.WriteString("{\n")
+			depth++
+		case tokClose:
+			if depth > 0 {
+				depth--
+			}
+			indent()
+			b.WriteString("}\n")
+			if depth == 0 {
+				b.WriteByte('\n')
+			}
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+// formatDeclaration normalizes the space after the colon of a declaration
+func formatDeclaration(decl string) string {
+	if strings.HasPrefix(decl, "@") {
+		return decl
+	}
+	depth := 0
+	for i := 0; i < len(decl); i++ {
+		switch decl[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '"', '\'':
+			return decl
+		case ':':
+			if depth == 0 {
+				return decl[:i] + ": " + strings.TrimSpace(decl[i+1:])
+			}
+		}
+	}
+	return decl
+}
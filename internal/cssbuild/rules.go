@@ -0,0 +1,61 @@
+package cssbuild
+
+import "strings"
+
+// Declaration is a single property: value pair
+type Declaration struct {
+	Property string
+	Value    string
+}
+
+// Rule is a block of declarations together with its selector and the
+// preludes of the at-rules it is nested in
+type Rule struct {
+	AtRules      []string // e.g. ["@media (prefers-color-scheme:dark)"]
+	Selector     string   // e.g. ":where(html)" or ".dark"
+	Declarations []Declaration
+}
+
+// ParseRules returns every block of css that contains declarations, in
+// source order. Comments are ignored.
+func ParseRules(css string) []Rule {
+	var rules []Rule
+	var stack []string // preludes of the open blocks
+	var open []int     // index into rules for each open block, -1 if none yet
+	var pending string // text waiting for its terminator
+
+	for _, t := range scan(css) {
+		switch t.kind {
+		case tokText:
+			pending = t.text
+		case tokOpen:
+			stack = append(stack, pending)
+			open = append(open, -1)
+			pending = ""
+		case tokSemi, tokClose:
+			if pending != "" && len(stack) > 0 {
+				if property, value, ok := strings.Cut(pending, ":"); ok {
+					top := len(open) - 1
+					if open[top] < 0 {
+						rules = append(rules, Rule{
+							AtRules:  append([]string(nil), stack[:len(stack)-1]...),
+							Selector: stack[len(stack)-1],
+						})
+						open[top] = len(rules) - 1
+					}
+					rules[open[top]].Declarations = append(rules[open[top]].Declarations, Declaration{
+						Property: strings.TrimSpace(property),
+						Value:    strings.TrimSpace(value),
+					})
+				}
+			}
+			pending = ""
+			if t.kind == tokClose && len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+				open = open[:len(open)-1]
+			}
+		}
+	}
+
+	return rules
+}
@@ -0,0 +1,240 @@
+package cssbuild
+
+import (
+	"sort"
+	"strings"
+)
+
+// Tokens holds the custom properties declared on the document root, with
+// every var() reference and light-dark() pair resolved to a literal value
+// and constant calc() arithmetic evaluated
+type Tokens struct {
+	Light map[string]string // Default and light theme values, keyed by name without "--"
+	Dark  map[string]string // Dark theme values, keyed by name without "--"
+
+	// Aliases maps the tokens declared as a single var() reference in both
+	// themes, such as --container-padding: var(--size-4), to the token they
+	// reference
+	Aliases map[string]string
+}
+
+// rootSelectors are the selectors whose custom properties apply to the whole document
+var rootSelectors = map[string]bool{
+	":where(html)": true,
+	":root":        true,
+	"html":         true,
+}
+
+// ExtractTokens collects the root custom properties of css for the light
+// and dark theme. Dark values come from the prefers-color-scheme: dark
+// media query and the .dark block; light values from the .light block.
+// Declarations in other at-rules (@supports, @media for p3 displays) are ignored,
+// as are postcss helper properties (--csstools-*) and properties that resolve
+// to an empty value in either theme.
+func ExtractTokens(css string) *Tokens {
+	light := make(map[string]string)
+	dark := make(map[string]string)
+	var lightOverrides, darkOverrides []Declaration
+
+	for _, rule := range ParseRules(css) {
+		switch {
+		case len(rule.AtRules) == 0 && rootSelectors[rule.Selector]:
+			setCustomProperties(light, rule.Declarations)
+			setCustomProperties(dark, rule.Declarations)
+		case len(rule.AtRules) == 0 && rule.Selector == ".light":
+			lightOverrides = append(lightOverrides, rule.Declarations...)
+		case len(rule.AtRules) == 0 && rule.Selector == ".dark":
+			darkOverrides = append(darkOverrides, rule.Declarations...)
+		case len(rule.AtRules) == 1 && isDarkMediaQuery(rule.AtRules[0]) && rootSelectors[rule.Selector]:
+			setCustomProperties(dark, rule.Declarations)
+		}
+	}
+	setCustomProperties(light, lightOverrides)
+	setCustomProperties(dark, darkOverrides)
+
+	tokens := &Tokens{
+		Light:   resolveAll(light, false),
+		Dark:    resolveAll(dark, true),
+		Aliases: aliases(light, dark),
+	}
+	for name := range tokens.Light {
+		if strings.HasPrefix(name, "csstools-") || tokens.Light[name] == "" || tokens.Dark[name] == "" {
+			delete(tokens.Light, name)
+			delete(tokens.Dark, name)
+		}
+	}
+	for name := range tokens.Dark {
+		if _, ok := tokens.Light[name]; !ok {
+			delete(tokens.Dark, name)
+		}
+	}
+	for name, ref := range tokens.Aliases {
+		if tokens.Light[name] == "" || tokens.Light[ref] == "" {
+			delete(tokens.Aliases, name)
+		}
+	}
+	return tokens
+}
+
+// aliases returns the properties whose declared value is the same single
+// var() reference to another property in both themes
+func aliases(light, dark map[string]string) map[string]string {
+	refs := make(map[string]string)
+	for name, value := range light {
+		if dark[name] != value {
+			continue
+		}
+		inner, ok := strings.CutPrefix(value, "var(--")
+		if !ok || !strings.HasSuffix(inner, ")") {
+			continue
+		}
+		ref := strings.TrimSuffix(inner, ")")
+		if _, ok := light[ref]; ok && ref != name && !strings.ContainsAny(ref, "(), ") {
+			refs[name] = ref
+		}
+	}
+	return refs
+}
+
+// Names returns all token names in sorted order
+func (t *Tokens) Names() []string {
+	names := make([]string, 0, len(t.Light))
+	for name := range t.Light {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isDarkMediaQuery reports whether prelude is a prefers-color-scheme: dark query
+func isDarkMediaQuery(prelude string) bool {
+	compact := strings.ReplaceAll(prelude, " ", "")
+	return strings.HasPrefix(compact, "@media") && strings.Contains(compact, "prefers-color-scheme:dark")
+}
+
+// setCustomProperties copies the custom properties of decls into props
+func setCustomProperties(props map[string]string, decls []Declaration) {
+	for _, d := range decls {
+		if name, ok := strings.CutPrefix(d.Property, "--"); ok {
+			props[name] = d.Value
+		}
+	}
+}
+
+// resolveAll resolves every property of props against the others
+func resolveAll(props map[string]string, dark bool) map[string]string {
+	resolved := make(map[string]string, len(props))
+	for name := range props {
+		resolved[name] = resolveProperty(name, props, dark, map[string]bool{})
+	}
+	return resolved
+}
+
+// resolveProperty returns the literal value of a single property
+func resolveProperty(name string, props map[string]string, dark bool, seen map[string]bool) string {
+	if seen[name] {
+		return ""
+	}
+	seen[name] = true
+	defer delete(seen, name)
+	value := Resolve(props[name], func(ref string) (string, bool) {
+		if _, ok := props[ref]; !ok {
+			return "", false
+		}
+		return resolveProperty(ref, props, dark, seen), true
+	}, dark)
+	// Empty substitutions such as --gradient-space leave stray spaces behind
+	value = strings.Join(strings.Fields(value), " ")
+	return evalCalc(strings.ReplaceAll(value, " ,", ","))
+}
+
+// Resolve substitutes var() references in value using lookup and picks one
+// side of light-dark() pairs. References that lookup cannot satisfy fall
+// back to the var() fallback, or are left untouched when there is none.
+func Resolve(value string, lookup func(name string) (string, bool), dark bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); {
+		fn := ""
+		switch {
+		case strings.HasPrefix(value[i:], "var("):
+			fn = "var"
+		case strings.HasPrefix(value[i:], "light-dark("):
+			fn = "light-dark"
+		}
+		if fn == "" || (i > 0 && isIdentChar(value[i-1])) {
+			b.WriteByte(value[i])
+			i++
+			continue
+		}
+
+		start := i + len(fn) + 1
+		end := matchingParen(value, start-1)
+		if end < 0 {
+			b.WriteString(value[i:])
+			break
+		}
+		args := splitArgs(value[start:end])
+
+		switch fn {
+		case "var":
+			name := strings.TrimPrefix(strings.TrimSpace(args[0]), "--")
+			if v, ok := lookup(name); ok {
+				b.WriteString(v)
+			} else if len(args) > 1 {
+				b.WriteString(Resolve(strings.Join(args[1:], ","), lookup, dark))
+			} else {
+				b.WriteString(value[i : end+1])
+			}
+		case "light-dark":
+			pick := args[0]
+			if dark && len(args) > 1 {
+				pick = args[1]
+			}
+			b.WriteString(Resolve(strings.TrimSpace(pick), lookup, dark))
+		}
+		i = end + 1
+	}
+	return b.String()
+}
+
+// matchingParen returns the index of the parenthesis closing the one at open
+func matchingParen(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitArgs splits function arguments at top-level commas
+func splitArgs(s string) []string {
+	var args []string
+	depth, last := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, s[last:i])
+				last = i + 1
+			}
+		}
+	}
+	return append(args, s[last:])
+}
+
+// isIdentChar reports whether c can be part of a CSS identifier
+func isIdentChar(c byte) bool {
+	return c == '-' || c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
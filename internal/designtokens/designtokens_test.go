@@ -0,0 +1,169 @@
+package designtokens
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/riclib/open-props-css/op"
+)
+
+func TestParseDTCG(t *testing.T) {
+	doc := `{
+  "primitive": {"size-4": {"$value": "1.25rem", "$type": "dimension"}},
+  "semantic": {
+    "surface": {
+      "$type": "color",
+      "base": {"$value": "#fff", "$extensions": {"com.github.riclib.open-props-css": {"dark": "#111"}}},
+      "raised": {"$value": "{primitive.gray-1}"}
+    },
+    "gap": {"$value": {"value": 4, "unit": "px"}, "$type": "dimension"},
+    "font-body": {"$value": ["Segoe UI", "sans-serif"], "$type": "fontFamily"},
+    "ease": {"$value": [0.25, 0, 0.3, 1], "$type": "cubicBezier"}
+  }
+}`
+
+	tokens, err := ParseDTCG([]byte(doc), "semantic")
+	if err != nil {
+		t.Fatalf("ParseDTCG() error: %v", err)
+	}
+
+	expected := []Token{
+		{"surface-base", "color", "#fff", "#111"},
+		{"surface-raised", "color", "var(--gray-1)", "var(--gray-1)"},
+		{"gap", "dimension", "4px", "4px"},
+		{"font-body", "fontFamily", `"Segoe UI", sans-serif`, `"Segoe UI", sans-serif`},
+		{"ease", "cubicBezier", "cubic-bezier(0.25, 0, 0.3, 1)", "cubic-bezier(0.25, 0, 0.3, 1)"},
+	}
+	if len(tokens) != len(expected) {
+		t.Fatalf("ParseDTCG() returned %d tokens, want %d", len(tokens), len(expected))
+	}
+	for i, want := range expected {
+		if tokens[i] != want {
+			t.Errorf("token %d = %+v, want %+v", i, tokens[i], want)
+		}
+	}
+
+	if _, err := ParseDTCG([]byte(`{"semantic": {"x": {"$value": "red; } body { color: red"}}}`), "semantic"); err == nil {
+		t.Error("ParseDTCG() should reject values that break out of the declaration")
+	}
+	if _, err := ParseDTCG([]byte(doc), "missing"); err == nil {
+		t.Error("ParseDTCG() should report a missing group")
+	}
+}
+
+func TestStylesheet(t *testing.T) {
+	css := Stylesheet([]string{`@import "open-props/open-props.min.css";`}, []Token{
+		{Name: "primary", Light: "#0066cc", Dark: "#3b82f6"},
+		{Name: "section-spacing", Light: "var(--size-7)", Dark: "var(--size-7)"},
+	})
+
+	for _, want := range []string{
+		`@import "open-props/open-props.min.css";`,
+		"--primary: light-dark(#0066cc, #3b82f6);",
+		"--section-spacing: var(--size-7);",
+		".light {\n  color-scheme: light;\n  --primary: #0066cc;\n}",
+		".dark {\n  color-scheme: dark;\n  --primary: #3b82f6;\n}",
+	} {
+		if !strings.Contains(css, want) {
+			t.Errorf("Stylesheet() missing %q in:\n%s", want, css)
+		}
+	}
+}
+
+func TestUpdateStylesheet(t *testing.T) {
+	css := `/* Theme */
+:root {
+  /* Colors */
+  --primary: light-dark(#0066cc, #3b82f6);
+  --shadow: light-dark(rgba(0, 0, 0, 0.1), rgba(0, 0, 0, 0.3));
+  --old: red;
+
+  /* Spacing */
+  --gap: var(--size-2);
+}
+
+.light {
+  color-scheme: light;
+  --primary: #0066cc;
+  --shadow: rgba(0, 0, 0, 0.1);
+}
+
+.dark {
+  color-scheme: dark;
+  --primary: #3b82f6;
+  --shadow: rgba(0, 0, 0, 0.3);
+}`
+
+	updated := UpdateStylesheet(css, []Token{
+		{Name: "primary", Light: "#0055aa", Dark: "#3b82f6"},
+		{Name: "shadow", Light: "rgba(0,0,0,0.1)", Dark: "rgba(0,0,0,0.3)"},
+		{Name: "gap", Light: "var(--size-3)", Dark: "var(--size-3)"},
+		{Name: "accent", Light: "#f90", Dark: "#fb3"},
+	})
+	expected := `/* Theme */
+:root {
+  /* Colors */
+  --primary: light-dark(#0055aa, #3b82f6);
+  --shadow: light-dark(rgba(0, 0, 0, 0.1), rgba(0, 0, 0, 0.3));
+
+  /* Spacing */
+  --gap: var(--size-3);
+  --accent: light-dark(#f90, #fb3);
+}
+
+.light {
+  color-scheme: light;
+  --primary: #0055aa;
+  --shadow: rgba(0, 0, 0, 0.1);
+  --accent: #f90;
+}
+
+.dark {
+  color-scheme: dark;
+  --primary: #3b82f6;
+  --shadow: rgba(0, 0, 0, 0.3);
+  --accent: #fb3;
+}`
+	if updated != expected {
+		t.Errorf("UpdateStylesheet() =\n%s\nwant\n%s", updated, expected)
+	}
+
+	if css := UpdateStylesheet("", []Token{{Name: "gap", Light: "1rem", Dark: "1rem"}}); !strings.Contains(css, ":root {") || !strings.Contains(css, "--gap: 1rem;") {
+		t.Errorf("UpdateStylesheet(empty) = %s, want a new stylesheet", css)
+	}
+}
+
+// TestRoundTrip exports the tokens and imports them again, which must leave
+// src/tokens.css untouched, aliases and comments included
+func TestRoundTrip(t *testing.T) {
+	css, err := os.ReadFile("../../src/tokens.css")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := op.ExportTokens(&buf, op.FormatDTCG); err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := ParseDTCG(buf.Bytes(), "semantic")
+	if err != nil {
+		t.Fatalf("ParseDTCG() error: %v", err)
+	}
+	if updated := UpdateStylesheet(string(css), tokens); updated != string(css) {
+		t.Errorf("round trip changed src/tokens.css:\n%s", updated)
+	}
+}
+
+func TestMethodName(t *testing.T) {
+	tests := map[string]string{
+		"surface-alt": "SurfaceAlt",
+		"text-1":      "Text1",
+		"primary":     "Primary",
+	}
+	for name, expected := range tests {
+		if result := methodName(name); result != expected {
+			t.Errorf("methodName(%q) = %q, want %q", name, result, expected)
+		}
+	}
+}
@@ -0,0 +1,338 @@
+package designtokens
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Extension is the $extensions key holding the dark theme value of a token.
+// It matches the key written by op.ExportTokens.
+const Extension = "com.github.riclib.open-props-css"
+
+// Token is a semantic design token with its light and dark CSS values
+type Token struct {
+	Name  string // Custom property name without "--"
+	Type  string // DTCG $type, possibly inherited from a group
+	Light string
+	Dark  string
+}
+
+// Themed reports whether the token has a different value in the dark theme
+func (t Token) Themed() bool {
+	return t.Light != t.Dark
+}
+
+var (
+	tokenNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+	aliasPattern     = regexp.MustCompile(`\{([^{}]+)\}`)
+	declPattern      = regexp.MustCompile(`^(\s*)--([a-z][a-z0-9-]*)\s*:\s*(.*?)\s*;\s*$`)
+)
+
+// member is one key of a JSON object, in document order
+type member struct {
+	key   string
+	value json.RawMessage
+}
+
+// ParseDTCG reads the tokens below group (e.g. "semantic") of a DTCG
+// document, in document order. Nested groups are flattened into dashed names
+// and aliases such as {primitive.size-4} become var(--size-4). An empty group
+// imports every token in the document.
+func ParseDTCG(data []byte, group string) ([]Token, error) {
+	root := json.RawMessage(data)
+	if group != "" {
+		for _, key := range strings.Split(group, ".") {
+			members, err := objectMembers(root)
+			if err != nil {
+				return nil, err
+			}
+			var found bool
+			for _, m := range members {
+				if m.key == key {
+					root, found = m.value, true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("group %q not found", group)
+			}
+		}
+	}
+
+	var tokens []Token
+	if err := collectTokens(root, "", "", &tokens); err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	for _, t := range tokens {
+		if seen[t.Name] {
+			return nil, fmt.Errorf("duplicate token --%s", t.Name)
+		}
+		seen[t.Name] = true
+	}
+	return tokens, nil
+}
+
+// collectTokens walks a DTCG group, appending its tokens to tokens
+func collectTokens(raw json.RawMessage, prefix, inheritedType string, tokens *[]Token) error {
+	members, err := objectMembers(raw)
+	if err != nil {
+		return err
+	}
+
+	var node struct {
+		Value      json.RawMessage            `json:"$value"`
+		Type       string                     `json:"$type"`
+		Extensions map[string]json.RawMessage `json:"$extensions"`
+	}
+	if err := json.Unmarshal(raw, &node); err != nil {
+		return err
+	}
+	if node.Type == "" {
+		node.Type = inheritedType
+	}
+
+	// A token: convert its value and dark extension
+	if node.Value != nil {
+		if !tokenNamePattern.MatchString(prefix) {
+			return fmt.Errorf("invalid token name %q", prefix)
+		}
+		t := Token{Name: prefix, Type: node.Type}
+		if t.Light, err = cssValue(node.Value, node.Type); err != nil {
+			return fmt.Errorf("token %s: %w", prefix, err)
+		}
+		t.Dark = t.Light
+		if ext, ok := node.Extensions[Extension]; ok {
+			var modes map[string]json.RawMessage
+			if err := json.Unmarshal(ext, &modes); err != nil {
+				return fmt.Errorf("token %s: %w", prefix, err)
+			}
+			if dark, ok := modes["dark"]; ok {
+				if t.Dark, err = cssValue(dark, node.Type); err != nil {
+					return fmt.Errorf("token %s: %w", prefix, err)
+				}
+			}
+		}
+		*tokens = append(*tokens, t)
+		return nil
+	}
+
+	// A group: recurse into every member that is not a $ property
+	for _, m := range members {
+		if strings.HasPrefix(m.key, "$") {
+			continue
+		}
+		name := m.key
+		if prefix != "" {
+			name = prefix + "-" + m.key
+		}
+		if err := collectTokens(m.value, name, node.Type, tokens); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// objectMembers returns the members of a JSON object in document order
+func objectMembers(raw json.RawMessage) ([]member, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+	var members []member
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		members = append(members, member{key: tok.(string), value: value})
+	}
+	return members, nil
+}
+
+// cssValue converts a DTCG $value to CSS
+func cssValue(raw json.RawMessage, kind string) (string, error) {
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return "", err
+	}
+
+	var value string
+	switch v := v.(type) {
+	case string:
+		value = v
+	case float64:
+		value = strconv.FormatFloat(v, 'f', -1, 64)
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			switch item := item.(type) {
+			case float64:
+				items[i] = strconv.FormatFloat(item, 'f', -1, 64)
+			case string:
+				items[i] = item
+				if kind == "fontFamily" && strings.ContainsAny(item, " ") {
+					items[i] = strconv.Quote(item)
+				}
+			default:
+				return "", fmt.Errorf("unsupported list item %v", item)
+			}
+		}
+		value = strings.Join(items, ", ")
+		if kind == "cubicBezier" {
+			value = "cubic-bezier(" + value + ")"
+		}
+	case map[string]any:
+		// Dimensions and durations in the {value, unit} form
+		n, ok1 := v["value"].(float64)
+		unit, ok2 := v["unit"].(string)
+		if !ok1 || !ok2 {
+			return "", fmt.Errorf("unsupported composite value")
+		}
+		value = strconv.FormatFloat(n, 'f', -1, 64) + unit
+	default:
+		return "", fmt.Errorf("unsupported value %v", v)
+	}
+
+	value = aliasPattern.ReplaceAllStringFunc(value, func(alias string) string {
+		path := strings.Split(strings.Trim(alias, "{}"), ".")
+		if len(path) > 1 && (path[0] == "primitive" || path[0] == "semantic") {
+			path = path[1:]
+		}
+		return "var(--" + strings.Join(path, "-") + ")"
+	})
+	if strings.ContainsAny(value, ";{}<>\\") || strings.Contains(value, "/*") {
+		return "", fmt.Errorf("unsafe CSS value %q", value)
+	}
+	return value, nil
+}
+
+// Stylesheet renders the semantic token stylesheet (src/tokens.css) with
+// the given @import lines, a :root block with light-dark() values and the
+// .light and .dark override blocks
+func Stylesheet(imports []string, tokens []Token) string {
+	var themed, static []Token
+	for _, t := range tokens {
+		if t.Themed() {
+			themed = append(themed, t)
+		} else {
+			static = append(static, t)
+		}
+	}
+
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New("stylesheet").Parse(stylesheetTemplate))
+	tmpl.Execute(&buf, map[string]any{
+		"Imports": imports,
+		"Themed":  themed,
+		"Static":  static,
+	})
+	return buf.String()
+}
+
+// Imports returns the @import lines of a stylesheet
+func Imports(css string) []string {
+	var imports []string
+	for _, line := range strings.Split(css, "\n") {
+		if line = strings.TrimSpace(line); strings.HasPrefix(line, "@import") {
+			imports = append(imports, line)
+		}
+	}
+	return imports
+}
+
+// UpdateStylesheet sets the tokens in the :root, .light and .dark blocks of
+// an existing semantic token stylesheet, keeping its comments, order and
+// formatting. Declarations whose value only differs in whitespace are left
+// as they are, tokens missing from tokens are removed and new ones are
+// appended to their block. A stylesheet without a :root block is rendered
+// from scratch by Stylesheet.
+func UpdateStylesheet(css string, tokens []Token) string {
+	blocks := map[string]map[string]string{":root": {}, ".light": {}, ".dark": {}}
+	for _, t := range tokens {
+		blocks[":root"][t.Name] = t.Light
+		if t.Themed() {
+			blocks[":root"][t.Name] = "light-dark(" + t.Light + ", " + t.Dark + ")"
+			blocks[".light"][t.Name] = t.Light
+			blocks[".dark"][t.Name] = t.Dark
+		}
+	}
+
+	var out []string
+	found := make(map[string]bool)
+	var block string // Selector of the enclosing managed block, if any
+	seen := make(map[string]bool)
+	depth := 0
+	for _, line := range strings.Split(css, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasSuffix(trimmed, "{"):
+			if selector := strings.TrimSpace(strings.TrimSuffix(trimmed, "{")); depth == 0 && blocks[selector] != nil {
+				block, found[selector] = selector, true
+				seen = make(map[string]bool)
+			}
+			depth++
+		case trimmed == "}":
+			depth--
+			if depth == 0 && block != "" {
+				for _, t := range tokens {
+					if value, ok := blocks[block][t.Name]; ok && !seen[t.Name] {
+						out = append(out, "  --"+t.Name+": "+value+";")
+					}
+				}
+				block = ""
+			}
+		case block != "" && depth == 1:
+			if m := declPattern.FindStringSubmatch(line); m != nil {
+				value, ok := blocks[block][m[2]]
+				if !ok || seen[m[2]] {
+					continue
+				}
+				seen[m[2]] = true
+				if compactValue(value) != compactValue(m[3]) {
+					line = m[1] + "--" + m[2] + ": " + value + ";"
+				}
+			}
+		}
+		out = append(out, line)
+	}
+	if !found[":root"] {
+		return Stylesheet(Imports(css), tokens)
+	}
+
+	result := strings.Join(out, "\n")
+	for _, selector := range []string{".light", ".dark"} {
+		if found[selector] || len(blocks[selector]) == 0 {
+			continue
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, "\n\n%s {\n  color-scheme: %s;\n", selector, strings.TrimPrefix(selector, "."))
+		for _, t := range tokens {
+			if value, ok := blocks[selector][t.Name]; ok {
+				fmt.Fprintf(&b, "  --%s: %s;\n", t.Name, value)
+			}
+		}
+		b.WriteString("}")
+		result = strings.TrimRight(result, "\n") + b.String() + "\n"
+	}
+	return result
+}
+
+// compactValue normalizes the whitespace of a CSS value for comparison, so
+// rgba(0, 0, 0, 0.1) matches the minified rgba(0,0,0,0.1)
+func compactValue(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	for _, r := range []string{", ", "( ", " )"} {
+		value = strings.ReplaceAll(value, r, strings.TrimSpace(r))
+	}
+	return value
+}
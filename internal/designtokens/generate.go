@@ -0,0 +1,171 @@
+// Package designtokens generates the op token tables and accessors from the
+// CSS sources and converts between the CSS and the DTCG design token format.
+package designtokens
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/riclib/open-props-css/internal/cssbuild"
+)
+
+// Config holds the configuration for generating the op token files
+type Config struct {
+	CSS          cssbuild.Config // Entry stylesheet and vendor directory
+	SemanticFile string          // Stylesheet declaring the project's semantic tokens
+	TableFile    string          // Output path for the resolved token table
+	ColorsFile   string          // Output path for the op.Color accessors
+	PackageName  string          // Go package name
+}
+
+// Result describes the generated files
+type Result struct {
+	Tokens int      // Number of resolved tokens
+	Colors []string // Generated op.Color methods
+}
+
+// colorAccessor is a generated op.Color method
+type colorAccessor struct {
+	Name   string // Token name, e.g. "surface-alt"
+	Method string // Method name, e.g. "SurfaceAlt"
+}
+
+// scaleColors are the Open Props color scales with hand-written accessors
+var scaleColors = map[string]bool{
+	"gray": true, "stone": true, "red": true, "pink": true, "purple": true,
+	"violet": true, "indigo": true, "blue": true, "cyan": true, "teal": true,
+	"green": true, "lime": true, "yellow": true, "orange": true, "choco": true,
+	"brown": true, "sand": true, "camo": true, "jungle": true,
+}
+
+// Generate builds the CSS, resolves every root token and writes the token
+// table and the op.Color accessors for the semantic color tokens
+func Generate(config Config) (*Result, error) {
+	built, err := cssbuild.Build(config.CSS)
+	if err != nil {
+		return nil, err
+	}
+	tokens := cssbuild.ExtractTokens(built.CSS)
+
+	semantic, err := semanticNames(config.SemanticFile, tokens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read semantic tokens: %w", err)
+	}
+
+	var colors []colorAccessor
+	for _, name := range semantic {
+		if !IsColor(tokens.Light[name]) {
+			continue
+		}
+		if scaleColors[name] {
+			return nil, fmt.Errorf("semantic token --%s collides with the op.Color scale accessor", name)
+		}
+		colors = append(colors, colorAccessor{Name: name, Method: methodName(name)})
+	}
+
+	source := "src/" + filepath.Base(config.CSS.Entry)
+	err = render(config.TableFile, tableTemplate, map[string]any{
+		"Source":      source,
+		"PackageName": config.PackageName,
+		"Names":       tokens.Names(),
+		"Light":       tokens.Light,
+		"Dark":        tokens.Dark,
+		"Aliases":     tokens.Aliases,
+		"Semantic":    semantic,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = render(config.ColorsFile, colorsTemplate, map[string]any{
+		"Source":      "src/" + filepath.Base(config.SemanticFile),
+		"PackageName": config.PackageName,
+		"Colors":      colors,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Tokens: len(tokens.Light)}
+	for _, c := range colors {
+		result.Colors = append(result.Colors, c.Method)
+	}
+	return result, nil
+}
+
+// render executes a Go source template, formats the result and writes it to path
+func render(path, text string, data any) error {
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New(filepath.Base(path)).Parse(text))
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to render %s: %w", path, err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", path, err)
+	}
+	return os.WriteFile(path, src, 0644)
+}
+
+// semanticNames returns the sorted custom properties declared in file that
+// made it into the bundled tokens
+func semanticNames(file string, tokens *cssbuild.Tokens) ([]string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var names []string
+	for _, rule := range cssbuild.ParseRules(string(data)) {
+		for _, d := range rule.Declarations {
+			name, ok := strings.CutPrefix(d.Property, "--")
+			if ok && !seen[name] && tokens.Light[name] != "" {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// methodName converts a token name to an exported Go method name
+func methodName(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "-") {
+		if part == "" {
+			continue
+		}
+		r := []rune(part)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	return b.String()
+}
+
+// colorPrefixes are the CSS functions and keywords that produce a color
+var colorPrefixes = []string{
+	"#", "rgb(", "rgba(", "hsl(", "hsla(", "hwb(", "lab(", "lch(", "oklab(", "oklch(",
+	"color(", "color-mix(", "light-dark(",
+}
+
+// IsColor reports whether a literal CSS value is a color
+func IsColor(value string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, prefix := range colorPrefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	switch value {
+	case "transparent", "currentcolor", "white", "black":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package designtokens
+
+// Template for the resolved token table
+const tableTemplate = `// Code generated by cmd/generate-tokens from {{.Source}}. DO NOT EDIT.
+
+package {{.PackageName}}
+
+// tokenValues maps every root custom property to its literal value in the
+// default (light) theme
+var tokenValues = map[string]string{
+{{range .Names}}	{{printf "%q" .}}: {{index $.Light . | printf "%q"}},
+{{end}}}
+
+// darkTokenValues holds the tokens whose value differs in the dark theme
+var darkTokenValues = map[string]string{
+{{range .Names}}{{if ne (index $.Light .) (index $.Dark .)}}	{{printf "%q" .}}: {{index $.Dark . | printf "%q"}},
+{{end}}{{end}}}
+
+// tokenAliases maps the tokens declared as a single var() reference to the
+// token they reference
+var tokenAliases = map[string]string{
+{{range $name := .Names}}{{with index $.Aliases $name}}	{{printf "%q" $name}}: {{printf "%q" .}},
+{{end}}{{end}}}
+
+// semanticTokens are the tokens declared by this project rather than Open Props
+var semanticTokens = map[string]bool{
+{{range .Semantic}}	{{printf "%q" .}}: true,
+{{end}}}
+`
+
+// Template for the semantic color accessors on op.Color
+const colorsTemplate = `// Code generated by cmd/generate-tokens from {{.Source}}. DO NOT EDIT.
+
+package {{.PackageName}}
+{{range .Colors}}
+// {{.Method}} returns the {{.Name}} theme color (--{{.Name}})
+func (c *colors) {{.Method}}() ColorValue {
+	return ColorValue(cssVar({{printf "%q" .Name}}))
+}
+{{end}}`
+
+// Template for the semantic token stylesheet
+const stylesheetTemplate = `{{if .Imports}}/* Import Open Props */
+{{range .Imports}}{{.}}
+{{end}}
+{{end}}/* Custom theme variables, generated by cmd/tokens -import */
+:root {
+{{- if .Themed}}
+  /* Semantic tokens that adapt to theme */
+{{- range .Themed}}
+  --{{.Name}}: light-dark({{.Light}}, {{.Dark}});
+{{- end}}
+{{- end}}
+{{- if .Static}}
+{{if .Themed}}
+{{end}}  /* Theme-independent tokens */
+{{- range .Static}}
+  --{{.Name}}: {{.Light}};
+{{- end}}
+{{- end}}
+}
+
+/* Light theme override */
+.light {
+  color-scheme: light;
+{{- range .Themed}}
+  --{{.Name}}: {{.Light}};
+{{- end}}
+}
+
+/* Dark theme override */
+.dark {
+  color-scheme: dark;
+{{- range .Themed}}
+  --{{.Name}}: {{.Dark}};
+{{- end}}
+}
+`
@@ -0,0 +1,176 @@
+package lucidegen
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// skipScanDirs are directories never scanned for icon references
+var skipScanDirs = map[string]bool{"vendor": true, "node_modules": true, "testdata": true}
+
+// filterIconsByAllowlist keeps the icons selected by config.Icons,
+// config.AllowlistFile and config.ScanDirs. It returns icons unchanged when
+// none of them is set.
+func filterIconsByAllowlist(icons []IconData, config Config) ([]IconData, error) {
+	if len(config.Icons) == 0 && config.AllowlistFile == "" && len(config.ScanDirs) == 0 {
+		return icons, nil
+	}
+
+	idents := iconIdentifiers(icons, config.Prefix)
+	allowed := make(map[string]bool)
+	allow := func(entry, source string) error {
+		name, ok := idents[entry]
+		if !ok {
+			return fmt.Errorf("unknown icon %q in %s", entry, source)
+		}
+		allowed[name] = true
+		return nil
+	}
+
+	for _, entry := range config.Icons {
+		if err := allow(strings.TrimSpace(entry), "allowlist"); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.AllowlistFile != "" {
+		entries, err := readAllowlist(config.AllowlistFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if err := allow(entry, config.AllowlistFile); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(config.ScanDirs) > 0 {
+		found, err := scanIconReferences(config.ScanDirs, config.PackageName, config.OutputDir, idents)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 0 {
+			return nil, fmt.Errorf("no %s.Icon* references found in %s", config.PackageName, strings.Join(config.ScanDirs, ", "))
+		}
+		if config.Verbose {
+			fmt.Printf("Found %d referenced icons in %s\n", len(found), strings.Join(config.ScanDirs, ", "))
+		}
+		for _, name := range found {
+			allowed[name] = true
+		}
+	}
+
+	var filtered []IconData
+	for _, icon := range icons {
+		if allowed[icon.Name] {
+			filtered = append(filtered, icon)
+		}
+	}
+	return filtered, nil
+}
+
+// iconIdentifiers maps every way of naming an icon to its Lucide name: the
+// name itself, its constant and its four component functions
+func iconIdentifiers(icons []IconData, prefix string) map[string]string {
+	idents := make(map[string]string, len(icons)*6)
+	for _, icon := range icons {
+		for _, ident := range []string{
+			icon.Name,
+			toConstantName(icon.Name, prefix),
+			icon.FuncName,
+			icon.FuncName + "WithAttrs",
+			icon.FuncName + "SVG",
+			icon.FuncName + "SVGWithAttrs",
+		} {
+			idents[ident] = icon.Name
+		}
+	}
+	return idents
+}
+
+// readAllowlist reads icon names or constants from a file, one per line.
+// Blank lines and lines starting with # are ignored.
+func readAllowlist(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read allowlist: %w", err)
+	}
+	defer file.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read allowlist: %w", err)
+	}
+	return entries, nil
+}
+
+// scanIconReferences returns the sorted names of the icons referenced as
+// pkg.Identifier in the .go and .templ files under dirs, e.g. icon.IconHouse,
+// icon.House() or icon.HouseWithAttrs(...). The output directory is skipped,
+// since the generated package references every icon.
+func scanIconReferences(dirs []string, pkg, outputDir string, idents map[string]string) ([]string, error) {
+	refPattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(pkg) + `\.([A-Z][A-Za-z0-9_]*)`)
+	skip := ""
+	if outputDir != "" {
+		if abs, err := filepath.Abs(outputDir); err == nil {
+			skip = abs
+		}
+	}
+
+	found := make(map[string]bool)
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				name := d.Name()
+				if path != dir && (strings.HasPrefix(name, ".") || skipScanDirs[name]) {
+					return filepath.SkipDir
+				}
+				if abs, err := filepath.Abs(path); err == nil && abs == skip {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(path, ".go") && !strings.HasSuffix(path, ".templ") {
+				return nil
+			}
+			source, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			for _, m := range refPattern.FindAllStringSubmatch(string(source), -1) {
+				if name, ok := idents[m[1]]; ok {
+					found[name] = true
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", dir, err)
+		}
+	}
+
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -17,7 +16,15 @@ type Config struct {
 	OutputDir     string   // Output directory path
 	PackageName   string   // Go package name
 	Prefix        string   // Function name prefix
+	Source        string   // Lucide checkout or icons directory, .tar.gz or .zip archive, or git URL (empty = clone from GitHub)
+	Version       string   // Lucide tag, branch or commit to clone, recorded in the lockfile (empty = locked version, or latest)
+	LockFile      string   // Lockfile path (empty = OutputDir/icons.lock.json)
+	Update        bool     // Regenerate even if the source no longer matches the lockfile, and rewrite it
+	AllowUnpinned bool     // Write a lockfile that records neither a version nor a commit
 	Categories    []string // Icon categories to include (empty = all)
+	Icons         []string // Icon names or constants to include (empty = all)
+	AllowlistFile string   // File listing icons to include, one per line
+	ScanDirs      []string // Include the icons referenced as PackageName.X in the .go and .templ files under these directories
 	DryRun        bool     // Preview without generating files
 	Verbose       bool     // Enable verbose logging
 	IncludeSearch bool     // Include search functionality (requires metadata fetching)
@@ -35,6 +42,7 @@ type IconData struct {
 	Contributors     []string `json:"contributors"`
 	Keywords         []string `json:"keywords"` // Deprecated: use Tags instead
 	Deprecated       bool     `json:"deprecated"`
+	Hash             string   `json:"hash"` // SHA-256 of the viewBox and drawing, as recorded in the lockfile
 }
 
 // GenerationResult contains information about the generation process
@@ -42,6 +50,9 @@ type GenerationResult struct {
 	IconsGenerated int           `json:"icons_generated"`
 	FilesCreated   []string      `json:"files_created"`
 	Categories     []string      `json:"categories"`
+	Version        string        `json:"version,omitempty"`
+	Commit         string        `json:"commit,omitempty"`
+	LockFile       string        `json:"lock_file"`
 	Duration       time.Duration `json:"duration"`
 }
 
@@ -159,17 +170,61 @@ func Generate(config Config) (*GenerationResult, error) {
 		config.PackageName = "icons"
 	}
 
-	// Fetch icons from GitHub
-	icons, err := fetchLucideIcons(config.Verbose, config.IncludeSearch)
+	if config.LockFile == "" {
+		config.LockFile = filepath.Join(config.OutputDir, LockFileName)
+	}
+
+	// Unless updating, regenerate from the locked version and commit
+	lock, err := readLock(config.LockFile)
+	if err != nil {
+		return nil, err
+	}
+	ref := config.Version
+	if lock != nil && !config.Update {
+		if config.Version != "" && config.Version != lock.Version {
+			return nil, fmt.Errorf("version %s does not match %s locked in %s (use -update to change it)", config.Version, orNone(lock.Version), config.LockFile)
+		}
+		if config.Version == "" {
+			config.Version = lock.Version
+		}
+		ref = config.Version
+		if lock.Commit != "" && (config.Source == "" || isGitURL(config.Source)) {
+			ref = lock.Commit
+		}
+	}
+
+	// Fetch icons from the configured source, GitHub by default
+	icons, commit, err := fetchLucideIcons(config, ref)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch icons: %w", err)
 	}
 
+	// Lock every icon of the source, so changing the filters does not
+	// count as an upstream change
+	current := newLock(config.Source, config.Version, commit, icons)
+	if lock != nil && !config.Update {
+		if diffs := lock.diff(current); len(diffs) > 0 {
+			return nil, fmt.Errorf("source does not match %s (use -update to accept the changes):\n  %s", config.LockFile, strings.Join(diffs, "\n  "))
+		}
+	}
+
+	// A lock without a version or commit cannot reproduce the icons, so
+	// writing one has to be asked for
+	if (lock == nil || config.Update) && current.Version == "" && current.Commit == "" && !config.AllowUnpinned {
+		return nil, fmt.Errorf("source has no version or commit to record in %s (use -version to pin a Lucide tag, or -allow-unpinned to write it anyway)", config.LockFile)
+	}
+
 	// Filter by categories if specified
 	if len(config.Categories) > 0 {
 		icons = filterIconsByCategories(icons, config.Categories)
 	}
 
+	// Keep only allowlisted icons, for packages trimmed to what a service uses
+	icons, err = filterIconsByAllowlist(icons, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply allowlist: %w", err)
+	}
+
 	// Sort icons by name for consistent output
 	sort.Slice(icons, func(i, j int) bool {
 		return icons[i].Name < icons[j].Name
@@ -182,6 +237,9 @@ func Generate(config Config) (*GenerationResult, error) {
 	result := &GenerationResult{
 		IconsGenerated: len(icons),
 		Categories:     getUniqueCategories(icons),
+		Version:        config.Version,
+		Commit:         commit,
+		LockFile:       config.LockFile,
 		Duration:       time.Since(start),
 	}
 
@@ -198,11 +256,21 @@ func Generate(config Config) (*GenerationResult, error) {
 		return nil, fmt.Errorf("failed to create output directory: %w", err)
 	}
 
-	// Generate files
-	files, err := generateFiles(icons, config)
+	// Write the lock on the first generation and when updating; otherwise
+	// the existing lock, which the source matches, goes in the headers
+	if lock == nil || config.Update {
+		lock = current
+	}
+	files, err := generateFiles(icons, config, lock)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate files: %w", err)
 	}
+	if lock == current {
+		if err := current.write(config.LockFile); err != nil {
+			return nil, err
+		}
+		files = append(files, config.LockFile)
+	}
 
 	result.FilesCreated = files
 	result.Duration = time.Since(start)
@@ -218,27 +286,30 @@ func Generate(config Config) (*GenerationResult, error) {
 	return result, nil
 }
 
-// fetchLucideIcons retrieves icon data from the Lucide GitHub repository via git clone
-func fetchLucideIcons(verbose bool, includeMetadata bool) ([]IconData, error) {
-	if verbose {
-		fmt.Println("Cloning Lucide repository...")
+// fetchLucideIcons retrieves icon data from config.Source: a git clone of the
+// Lucide repository at ref by default, or a local checkout or archive. It
+// also returns the upstream commit, or "" if the source does not record it.
+func fetchLucideIcons(config Config, ref string) ([]IconData, string, error) {
+	root, commit, cleanup, err := openSource(config.Source, ref, config.Verbose)
+	if err != nil {
+		return nil, "", err
 	}
+	defer cleanup()
 
-	// Create temporary directory for git clone
-	tempDir, err := os.MkdirTemp("", "lucide-clone-*")
+	iconsDir, err := findIconsDir(root)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+		return nil, "", err
 	}
-	defer os.RemoveAll(tempDir) // Clean up
-
-	// Clone the repository (shallow clone for speed)
-	cmd := exec.Command("git", "clone", "--depth", "1", "https://github.com/lucide-icons/lucide.git", tempDir)
-	if err := cmd.Run(); err != nil {
-		return nil, fmt.Errorf("failed to clone repository: %w", err)
+	icons, err := readIconsDir(iconsDir, config.Verbose, config.IncludeSearch)
+	if err != nil {
+		return nil, "", err
 	}
+	return icons, commit, nil
+}
 
-	iconsDir := filepath.Join(tempDir, "icons")
-
+// readIconsDir parses the SVG files, and optionally the JSON metadata, of a
+// Lucide icons directory
+func readIconsDir(iconsDir string, verbose bool, includeMetadata bool) ([]IconData, error) {
 	// Read all SVG files
 	files, err := os.ReadDir(iconsDir)
 	if err != nil {
@@ -336,6 +407,7 @@ func parseLocalIcon(svgPath, iconName, iconsDir string, includeMetadata bool) (*
 		Tags:             metadata.Tags,
 		LucideCategories: metadata.Categories,
 		Contributors:     metadata.Contributors,
+		Hash:             hashIcon(svg.ViewBox, svg.Content),
 	}, nil
 }
 
@@ -423,35 +495,48 @@ func toConstantName(name, prefix string) string {
 	return "Icon" + funcName
 }
 
-// generateFiles creates all the template files
-func generateFiles(icons []IconData, config Config) ([]string, error) {
+// generateFiles creates all the template files, with the version and commit
+// of lock in their headers
+func generateFiles(icons []IconData, config Config, lock *Lock) ([]string, error) {
 	var createdFiles []string
 
 	// Generate main icons file
 	iconsFile := filepath.Join(config.OutputDir, "icons.templ")
-	if err := generateIconsFile(icons, config, iconsFile); err != nil {
+	if err := generateIconsFile(icons, config, lock, iconsFile); err != nil {
 		return nil, fmt.Errorf("failed to generate icons file: %w", err)
 	}
 	createdFiles = append(createdFiles, iconsFile)
 
-	// Generate registry file
-	registryFile := filepath.Join(config.OutputDir, "registry.templ")
-	if err := generateRegistryFile(icons, config, registryFile); err != nil {
+	// Generate registry file, replacing the templ registry of older versions
+	registryFile := filepath.Join(config.OutputDir, "registry.go")
+	if err := generateRegistryFile(icons, config, lock, registryFile); err != nil {
 		return nil, fmt.Errorf("failed to generate registry file: %w", err)
 	}
 	createdFiles = append(createdFiles, registryFile)
+	for _, stale := range []string{"registry.templ", "registry_templ.go"} {
+		if err := os.Remove(filepath.Join(config.OutputDir, stale)); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to remove %s: %w", stale, err)
+		}
+	}
 
 	// Generate categories file
 	categoriesFile := filepath.Join(config.OutputDir, "categories.go")
-	if err := generateCategoriesFile(icons, config, categoriesFile); err != nil {
+	if err := generateCategoriesFile(icons, config, lock, categoriesFile); err != nil {
 		return nil, fmt.Errorf("failed to generate categories file: %w", err)
 	}
 	createdFiles = append(createdFiles, categoriesFile)
 
+	// Generate sprite sheet file
+	spriteFile := filepath.Join(config.OutputDir, "sprite.go")
+	if err := generateSpriteFile(config, lock, spriteFile); err != nil {
+		return nil, fmt.Errorf("failed to generate sprite file: %w", err)
+	}
+	createdFiles = append(createdFiles, spriteFile)
+
 	// Generate search file (optional)
 	if config.IncludeSearch {
 		searchFile := filepath.Join(config.OutputDir, "search.go")
-		if err := generateSearchFile(icons, config, searchFile); err != nil {
+		if err := generateSearchFile(icons, config, lock, searchFile); err != nil {
 			return nil, fmt.Errorf("failed to generate search file: %w", err)
 		}
 		createdFiles = append(createdFiles, searchFile)
@@ -0,0 +1,138 @@
+package lucidegen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+)
+
+// LockFileName is the lockfile written next to the generated package
+const LockFileName = "icons.lock.json"
+
+// maxLockDiffNames caps the icons listed per kind of lockfile difference
+const maxLockDiffNames = 10
+
+// Lock pins the Lucide release a package was generated from: the version
+// requested, the upstream commit when it is known and the hash of every icon
+// the source provided, so a regeneration can tell when upstream has changed
+type Lock struct {
+	Source  string            `json:"source"`
+	Version string            `json:"version,omitempty"`
+	Commit  string            `json:"commit,omitempty"`
+	Icons   map[string]string `json:"icons"`
+}
+
+// hashIcon returns the hash recorded for an icon: its viewBox and drawing,
+// so reformatting the SVG file upstream does not count as a change
+func hashIcon(viewBox, content string) string {
+	sum := sha256.Sum256([]byte(viewBox + "\n" + strings.TrimSpace(content)))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// newLock records icons as fetched from source at version and commit
+func newLock(source, version, commit string, icons []IconData) *Lock {
+	if source == "" {
+		source = DefaultRepository
+	}
+	lock := &Lock{
+		Source:  source,
+		Version: version,
+		Commit:  commit,
+		Icons:   make(map[string]string, len(icons)),
+	}
+	for _, icon := range icons {
+		lock.Icons[icon.Name] = icon.Hash
+	}
+	return lock
+}
+
+// readLock reads a lockfile, returning nil if it does not exist
+func readLock(path string) (*Lock, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lockfile: %w", err)
+	}
+	var lock Lock
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("failed to parse lockfile %s: %w", path, err)
+	}
+	return &lock, nil
+}
+
+// write saves the lock as indented JSON, with icons in name order
+func (l *Lock) write(path string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode lockfile: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write lockfile: %w", err)
+	}
+	return nil
+}
+
+// diff describes how current differs from the lock, one line per kind of
+// difference, or returns nil when they match. Versions must match even
+// when one side has none. The source is not compared, and the commit only
+// when both sides know it, so a release archive can stand in for a clone
+// of the same commit.
+func (l *Lock) diff(current *Lock) []string {
+	var diffs []string
+	if l.Version != current.Version {
+		diffs = append(diffs, fmt.Sprintf("version %s, locked %s", orNone(current.Version), orNone(l.Version)))
+	}
+	if l.Commit != "" && current.Commit != "" && l.Commit != current.Commit {
+		diffs = append(diffs, fmt.Sprintf("commit %s, locked %s", current.Commit, l.Commit))
+	}
+
+	var added, removed, changed []string
+	for name, hash := range current.Icons {
+		locked, ok := l.Icons[name]
+		switch {
+		case !ok:
+			added = append(added, name)
+		case locked != hash:
+			changed = append(changed, name)
+		}
+	}
+	for name := range l.Icons {
+		if _, ok := current.Icons[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	for _, group := range []struct {
+		kind  string
+		names []string
+	}{{"added", added}, {"removed", removed}, {"changed", changed}} {
+		if len(group.names) > 0 {
+			diffs = append(diffs, fmt.Sprintf("%d icons %s: %s", len(group.names), group.kind, summarizeNames(group.names)))
+		}
+	}
+	return diffs
+}
+
+// orNone returns s, or "none" when it is empty
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+// summarizeNames lists names in order, eliding all but the first few
+func summarizeNames(names []string) string {
+	sort.Strings(names)
+	if len(names) <= maxLockDiffNames {
+		return strings.Join(names, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(names[:maxLockDiffNames], ", "), len(names)-maxLockDiffNames)
+}
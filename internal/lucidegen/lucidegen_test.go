@@ -0,0 +1,308 @@
+package lucidegen
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// testIcons returns icons with the names given, as parsed from Lucide
+func testIcons(names ...string) []IconData {
+	icons := make([]IconData, len(names))
+	for i, name := range names {
+		icons[i] = IconData{Name: name, FuncName: toFunctionName(name), ViewBox: "0 0 24 24"}
+	}
+	return icons
+}
+
+func iconNames(icons []IconData) []string {
+	names := make([]string, len(icons))
+	for i, icon := range icons {
+		names[i] = icon.Name
+	}
+	return names
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFilterIconsByAllowlist(t *testing.T) {
+	icons := testIcons("arrow-right", "check", "house", "pencil", "trash-2", "x")
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "icons.txt"), "# Row actions\npencil\n\nIconTrash2\n")
+	writeFile(t, filepath.Join(dir, "app", "page.templ"), `templ Page() {
+	@icons.Icon(icons.IconHouse, nil)
+	@icons.CheckWithAttrs(templ.Attributes{"class": "icon-sm"})
+	@other.IconX()
+}`)
+	writeFile(t, filepath.Join(dir, "app", "handler.go"), `var back = icons.ArrowRightSVG()`)
+	writeFile(t, filepath.Join(dir, "app", "vendor", "lib.go"), `var skipped = icons.IconX`)
+	writeFile(t, filepath.Join(dir, "icons", "registry.go"), `var all = []IconName{icons.IconX}`)
+
+	tests := []struct {
+		name     string
+		config   Config
+		expected []string
+	}{
+		{"No allowlist", Config{}, []string{"arrow-right", "check", "house", "pencil", "trash-2", "x"}},
+		{"Names and constants", Config{Icons: []string{"x", "IconCheck"}}, []string{"check", "x"}},
+		{"File", Config{AllowlistFile: filepath.Join(dir, "icons.txt")}, []string{"pencil", "trash-2"}},
+		{
+			"Scan",
+			Config{PackageName: "icons", OutputDir: filepath.Join(dir, "icons"), ScanDirs: []string{dir}},
+			[]string{"arrow-right", "check", "house"},
+		},
+		{
+			"Combined",
+			Config{PackageName: "icons", OutputDir: filepath.Join(dir, "icons"), ScanDirs: []string{filepath.Join(dir, "app")}, Icons: []string{"x"}},
+			[]string{"arrow-right", "check", "house", "x"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, err := filterIconsByAllowlist(icons, tt.config)
+			if err != nil {
+				t.Fatalf("filterIconsByAllowlist() error: %v", err)
+			}
+			if names := iconNames(filtered); !reflect.DeepEqual(names, tt.expected) {
+				t.Errorf("filterIconsByAllowlist() = %v, want %v", names, tt.expected)
+			}
+		})
+	}
+
+	if _, err := filterIconsByAllowlist(icons, Config{Icons: []string{"no-such-icon"}}); err == nil || !strings.Contains(err.Error(), "no-such-icon") {
+		t.Errorf("filterIconsByAllowlist(unknown) error = %v, want unknown icon", err)
+	}
+	empty := t.TempDir()
+	if _, err := filterIconsByAllowlist(icons, Config{PackageName: "icons", ScanDirs: []string{empty}}); err == nil {
+		t.Error("filterIconsByAllowlist() should fail when the scan finds no references")
+	}
+}
+
+// testSourceFiles are the files of a minimal Lucide release
+var testSourceFiles = map[string]string{
+	"lucide-0.1.0/icons/house.svg":   `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24"><path d="M3 10 12 3l9 7" /></svg>`,
+	"lucide-0.1.0/icons/house.json":  `{"tags": ["home"], "categories": ["buildings"]}`,
+	"lucide-0.1.0/icons/pencil.svg":  `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24"><path d="M12 20h9" /></svg>`,
+	"lucide-0.1.0/README.md":         "# Lucide",
+	"lucide-0.1.0/docs/icons/x.html": "<p>not an icon</p>",
+}
+
+func TestFetchLucideIconsOffline(t *testing.T) {
+	dir := t.TempDir()
+	names := make([]string, 0, len(testSourceFiles))
+	for name := range testSourceFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeFile(t, filepath.Join(dir, "tree", name), testSourceFiles[name])
+	}
+
+	tarball := filepath.Join(dir, "lucide.tar.gz")
+	{
+		f, err := os.Create(tarball)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gz := gzip.NewWriter(f)
+		tw := tar.NewWriter(gz)
+		for _, name := range names {
+			content := testSourceFiles[name]
+			tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+			tw.Write([]byte(content))
+		}
+		tw.Close()
+		gz.Close()
+		f.Close()
+	}
+
+	zipball := filepath.Join(dir, "lucide.zip")
+	{
+		f, err := os.Create(zipball)
+		if err != nil {
+			t.Fatal(err)
+		}
+		zw := zip.NewWriter(f)
+		for _, name := range names {
+			w, _ := zw.Create(name)
+			w.Write([]byte(testSourceFiles[name]))
+		}
+		zw.Close()
+		f.Close()
+	}
+
+	sources := []string{
+		filepath.Join(dir, "tree"),
+		filepath.Join(dir, "tree", "lucide-0.1.0"),
+		filepath.Join(dir, "tree", "lucide-0.1.0", "icons"),
+		tarball,
+		zipball,
+	}
+	for _, source := range sources {
+		icons, _, err := fetchLucideIcons(Config{Source: source, IncludeSearch: true}, "")
+		if err != nil {
+			t.Errorf("fetchLucideIcons(%s) error: %v", source, err)
+			continue
+		}
+		sort.Slice(icons, func(i, j int) bool { return icons[i].Name < icons[j].Name })
+		if names := iconNames(icons); !reflect.DeepEqual(names, []string{"house", "pencil"}) {
+			t.Errorf("fetchLucideIcons(%s) = %v, want [house pencil]", source, names)
+			continue
+		}
+		if house := icons[0]; house.FuncName != "House" || house.ViewBox != "0 0 24 24" || house.Content != `<path d="M3 10 12 3l9 7" />` || house.Category != "buildings" {
+			t.Errorf("fetchLucideIcons(%s) house = %+v", source, house)
+		}
+	}
+
+	if _, _, err := fetchLucideIcons(Config{Source: filepath.Join(dir, "tree", "lucide-0.1.0", "README.md")}, ""); err == nil {
+		t.Error("fetchLucideIcons() should reject unsupported files")
+	}
+	if _, _, err := fetchLucideIcons(Config{Source: filepath.Join(dir, "missing")}, ""); err == nil {
+		t.Error("fetchLucideIcons() should fail for a missing source")
+	}
+
+	// Archive entries must stay inside the extraction directory
+	evil := filepath.Join(dir, "evil.zip")
+	{
+		f, _ := os.Create(evil)
+		zw := zip.NewWriter(f)
+		w, _ := zw.Create("../escape.svg")
+		w.Write([]byte("<svg></svg>"))
+		zw.Close()
+		f.Close()
+	}
+	if _, _, err := fetchLucideIcons(Config{Source: evil}, ""); err == nil || !strings.Contains(err.Error(), "unsafe path") {
+		t.Errorf("fetchLucideIcons(evil.zip) error = %v, want unsafe path", err)
+	}
+}
+
+func TestLockFile(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "lucide")
+	for name, content := range testSourceFiles {
+		writeFile(t, filepath.Join(source, name), content)
+	}
+	config := Config{Source: source, OutputDir: filepath.Join(dir, "icon"), PackageName: "icon", Version: "0.1.0"}
+	lockPath := filepath.Join(config.OutputDir, LockFileName)
+
+	// The first generation writes the lock, for every icon of the source
+	result, err := Generate(Config{Source: config.Source, OutputDir: config.OutputDir, PackageName: "icon", Version: "0.1.0", Icons: []string{"house"}})
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+	if result.LockFile != lockPath || !contains(result.FilesCreated, lockPath) {
+		t.Errorf("Generate() lock = %s in %v, want %s", result.LockFile, result.FilesCreated, lockPath)
+	}
+	lock, err := readLock(lockPath)
+	if err != nil || lock == nil {
+		t.Fatalf("readLock() = %v, %v", lock, err)
+	}
+	house := testSourceFiles["lucide-0.1.0/icons/house.svg"]
+	if lock.Version != "0.1.0" || len(lock.Icons) != 2 || lock.Icons["house"] != hashIcon("0 0 24 24", `<path d="M3 10 12 3l9 7" />`) {
+		t.Errorf("lock = %+v", lock)
+	}
+
+	// An unchanged source regenerates, at the locked version by default, and
+	// produces the same files every time
+	config.Version = ""
+	if result, err := Generate(config); err != nil || result.Version != "0.1.0" {
+		t.Errorf("Generate(unchanged) = %+v, %v, want version 0.1.0", result, err)
+	}
+	registry := filepath.Join(config.OutputDir, "registry.go")
+	before, err := os.ReadFile(registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header := "// Code generated by lucide-templ-gen from Lucide version 0.1.0. DO NOT EDIT.\n"; !strings.HasPrefix(string(before), header) {
+		t.Errorf("registry.go header = %q, want %q", strings.SplitAfter(string(before), "\n")[0], header)
+	}
+	if _, err := Generate(config); err != nil {
+		t.Fatalf("Generate(again) error: %v", err)
+	}
+	if after, _ := os.ReadFile(registry); string(after) != string(before) {
+		t.Error("Generate(again) changed registry.go")
+	}
+
+	config.Version = "0.2.0"
+	if _, err := Generate(config); err == nil || !strings.Contains(err.Error(), "does not match 0.1.0") {
+		t.Errorf("Generate(version 0.2.0) error = %v, want version mismatch", err)
+	}
+
+	// Changed and added icons are refused until the lock is updated
+	writeFile(t, filepath.Join(source, "lucide-0.1.0", "icons", "house.svg"), strings.Replace(house, "M3 10", "M4 10", 1))
+	writeFile(t, filepath.Join(source, "lucide-0.1.0", "icons", "x.svg"), `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24"><path d="M18 6 6 18" /></svg>`)
+	config.Version = ""
+	_, err = Generate(config)
+	if err == nil || !strings.Contains(err.Error(), "1 icons changed: house") || !strings.Contains(err.Error(), "1 icons added: x") {
+		t.Errorf("Generate(changed) error = %v, want house changed and x added", err)
+	}
+
+	config.Version = "0.2.0"
+	config.Update = true
+	if _, err := Generate(config); err != nil {
+		t.Fatalf("Generate(update) error: %v", err)
+	}
+	if lock, _ := readLock(lockPath); lock.Version != "0.2.0" || len(lock.Icons) != 3 {
+		t.Errorf("updated lock = %+v, want version 0.2.0 with 3 icons", lock)
+	}
+}
+
+func TestLockFileUnpinned(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "lucide")
+	for name, content := range testSourceFiles {
+		writeFile(t, filepath.Join(source, name), content)
+	}
+	config := Config{Source: source, OutputDir: filepath.Join(dir, "icon"), PackageName: "icon"}
+
+	// Without a version or commit the lock is only written when asked for
+	if _, err := Generate(config); err == nil || !strings.Contains(err.Error(), "-allow-unpinned") {
+		t.Errorf("Generate(unpinned) error = %v, want refusal", err)
+	}
+	config.AllowUnpinned = true
+	if _, err := Generate(config); err != nil {
+		t.Fatalf("Generate(allow unpinned) error: %v", err)
+	}
+	registry, err := os.ReadFile(filepath.Join(config.OutputDir, "registry.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header := "// Code generated by lucide-templ-gen from an unpinned Lucide source. DO NOT EDIT.\n"; !strings.HasPrefix(string(registry), header) {
+		t.Errorf("registry.go header = %q, want %q", strings.SplitAfter(string(registry), "\n")[0], header)
+	}
+
+	// Pinning a version is a change to the lock like any other
+	config.AllowUnpinned = false
+	config.Version = "0.1.0"
+	if _, err := Generate(config); err == nil || !strings.Contains(err.Error(), "does not match none") {
+		t.Errorf("Generate(version 0.1.0) error = %v, want version mismatch", err)
+	}
+	config.Update = true
+	if _, err := Generate(config); err != nil {
+		t.Fatalf("Generate(update) error: %v", err)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,265 @@
+package lucidegen
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DefaultRepository is the Lucide repository cloned when Config.Source is empty
+const DefaultRepository = "https://github.com/lucide-icons/lucide.git"
+
+// openSource makes the icons of source available as a local directory and
+// reports the upstream commit when it is known:
+//
+//   - "" or a git URL is cloned, at ref (a tag, branch or commit) if set
+//   - a directory is used as is: a Lucide checkout, an extracted release or
+//     the icons directory itself
+//   - a .tar.gz, .tgz or .zip archive is extracted
+//
+// The returned cleanup removes temporary files.
+func openSource(source, ref string, verbose bool) (dir, commit string, cleanup func(), err error) {
+	noop := func() {}
+	if source == "" {
+		source = DefaultRepository
+	}
+
+	if isGitURL(source) {
+		tempDir, err := os.MkdirTemp("", "lucide-clone-*")
+		if err != nil {
+			return "", "", noop, fmt.Errorf("failed to create temp directory: %w", err)
+		}
+		cleanup := func() { os.RemoveAll(tempDir) }
+		if verbose {
+			if ref != "" {
+				fmt.Printf("Cloning %s at %s...\n", source, ref)
+			} else {
+				fmt.Printf("Cloning %s...\n", source)
+			}
+		}
+		if err := cloneRepository(source, ref, tempDir); err != nil {
+			cleanup()
+			return "", "", noop, err
+		}
+		return tempDir, gitCommit(tempDir), cleanup, nil
+	}
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return "", "", noop, fmt.Errorf("failed to open source: %w", err)
+	}
+	if info.IsDir() {
+		if verbose {
+			fmt.Printf("Reading icons from %s...\n", source)
+		}
+		// Only a checkout's own .git, not that of an enclosing repository
+		if _, err := os.Stat(filepath.Join(source, ".git")); err == nil {
+			commit = gitCommit(source)
+		}
+		return source, commit, noop, nil
+	}
+
+	var extract func(archive, dir string) (string, error)
+	switch lower := strings.ToLower(source); {
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		extract = extractTarGz
+	case strings.HasSuffix(lower, ".zip"):
+		extract = extractZip
+	default:
+		return "", "", noop, fmt.Errorf("unsupported source %s: want a directory, .tar.gz, .tgz or .zip archive, or git URL", source)
+	}
+
+	tempDir, err := os.MkdirTemp("", "lucide-source-*")
+	if err != nil {
+		return "", "", noop, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	cleanup = func() { os.RemoveAll(tempDir) }
+	if verbose {
+		fmt.Printf("Extracting %s...\n", source)
+	}
+	commit, err = extract(source, tempDir)
+	if err != nil {
+		cleanup()
+		return "", "", noop, fmt.Errorf("failed to extract %s: %w", source, err)
+	}
+	return tempDir, commit, cleanup, nil
+}
+
+// cloneRepository makes a shallow clone of url in dir. A ref is fetched on
+// its own, which works for tags and branches as well as commit hashes.
+func cloneRepository(url, ref, dir string) error {
+	steps := [][]string{{"clone", "--depth", "1", url, dir}}
+	if ref != "" {
+		steps = [][]string{
+			{"init", "--quiet", dir},
+			{"-C", dir, "fetch", "--quiet", "--depth", "1", url, ref},
+			{"-C", dir, "checkout", "--quiet", "FETCH_HEAD"},
+		}
+	}
+	for _, args := range steps {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to clone repository: git %s: %w\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+	return nil
+}
+
+// gitCommit returns the commit checked out in dir, or "" if it is unknown
+func gitCommit(dir string) string {
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// isGitURL reports whether source names a remote repository rather than a
+// local path
+func isGitURL(source string) bool {
+	for _, prefix := range []string{"https://", "http://", "ssh://", "git://", "git@"} {
+		if strings.HasPrefix(source, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIconFile reports whether an archive entry is an icon or its metadata
+func isIconFile(name string) bool {
+	return strings.HasSuffix(name, ".svg") || strings.HasSuffix(name, ".json")
+}
+
+// extractTarGz writes the icon files of a gzipped tarball under dir. It
+// returns the commit that GitHub records in the comment of its archives.
+func extractTarGz(archive, dir string) (commit string, err error) {
+	file, err := os.Open(archive)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return "", err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return commit, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if header.Typeflag == tar.TypeXGlobalHeader && isCommitHash(header.PAXRecords["comment"]) {
+			commit = header.PAXRecords["comment"]
+		}
+		if header.Typeflag != tar.TypeReg || !isIconFile(header.Name) {
+			continue
+		}
+		if err := extractFile(dir, header.Name, tr); err != nil {
+			return "", err
+		}
+	}
+}
+
+// extractZip writes the icon files of a zip archive under dir. It returns
+// the commit that GitHub records in the comment of its archives.
+func extractZip(archive, dir string) (commit string, err error) {
+	zr, err := zip.OpenReader(archive)
+	if err != nil {
+		return "", err
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || !isIconFile(f.Name) {
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+		err = extractFile(dir, f.Name, r)
+		r.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+	if isCommitHash(zr.Comment) {
+		commit = zr.Comment
+	}
+	return commit, nil
+}
+
+// isCommitHash reports whether s is a full hexadecimal git commit hash
+func isCommitHash(s string) bool {
+	if len(s) != 40 && len(s) != 64 {
+		return false
+	}
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// extractFile writes one archive entry under dir, rejecting names that
+// would escape it
+func extractFile(dir, name string, r io.Reader) error {
+	name = filepath.FromSlash(name)
+	if !filepath.IsLocal(name) {
+		return fmt.Errorf("unsafe path %q in archive", name)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// findIconsDir locates the directory holding the SVG files: root/icons as in
+// a Lucide checkout, root itself, or the same one level down, where GitHub
+// release archives put their top-level directory
+func findIconsDir(root string) (string, error) {
+	candidates := []string{filepath.Join(root, "icons"), root}
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return "", fmt.Errorf("failed to read source: %w", err)
+	}
+	var subdirs []string
+	for _, entry := range entries {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			subdirs = append(subdirs, entry.Name())
+		}
+	}
+	sort.Strings(subdirs)
+	for _, sub := range subdirs {
+		candidates = append(candidates, filepath.Join(root, sub, "icons"), filepath.Join(root, sub))
+	}
+
+	for _, dir := range candidates {
+		if matches, _ := filepath.Glob(filepath.Join(dir, "*.svg")); len(matches) > 0 {
+			return dir, nil
+		}
+	}
+	return "", fmt.Errorf("no icons directory with SVG files found in source")
+}
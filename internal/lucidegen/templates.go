@@ -1,14 +1,23 @@
 package lucidegen
 
 import (
+	"bytes"
+	"fmt"
+	"go/format"
 	"os"
+	"regexp"
 	"strings"
 	"text/template"
-	"time"
 )
 
+// generatedHeader starts every generated file. It names the Lucide version
+// and commit recorded in the lockfile rather than a time, so regenerating
+// from the same lock produces the same files, and says so when the lock
+// records neither.
+const generatedHeader = `// Code generated by lucide-templ-gen from {{if or .Version .Commit}}Lucide{{with .Version}} version {{.}}{{end}}{{with .Commit}}{{if $.Version}},{{end}} commit {{.}}{{end}}{{else}}an unpinned Lucide source{{end}}. DO NOT EDIT.`
+
 // Template for individual icon components
-const iconsTemplate = `// Code generated by lucide-templ-gen on {{.Timestamp}}. DO NOT EDIT.
+const iconsTemplate = generatedHeader + `
 // Source: https://github.com/lucide-icons/lucide
 // Generator: https://github.com/riclib/open-props-css
 
@@ -78,11 +87,21 @@ templ {{.FuncName}}SVGWithAttrs(attrs templ.Attributes) {
 }
 {{end}}`
 
-// Template for icon registry
-const registryTemplate = `// Code generated by lucide-templ-gen on {{.Timestamp}}. DO NOT EDIT.
+// Template for the icon registry (a .go file, not .templ). The icons live in
+// one table sorted by name and render through a single component, rather than
+// a switch with a case per icon in each lookup.
+const registryTemplate = generatedHeader + `
 
 package {{.PackageName}}
 
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/a-h/templ"
+)
+
 // IconName represents a valid Lucide icon name
 type IconName string
 
@@ -91,58 +110,116 @@ const (
 {{range .Icons}}	{{call $.ToConstantName .Name $.Prefix}} IconName = "{{.Name}}"
 {{end}})
 
+// iconData is the SVG source of an icon, with the content as templ renders it
+type iconData struct {
+	name    IconName
+	viewBox string
+	content string
+}
+
+// iconTable holds every icon, sorted by name
+var iconTable = []iconData{
+{{range .Icons}}	{ {{- call $.ToConstantName .Name $.Prefix}}, "{{.ViewBox}}", {{printf "%q" (call $.RenderedContent .Content)}}},
+{{end}}}
+
+// lookupIcon returns the table entry of an icon, found by binary search
+func lookupIcon(name IconName) (*iconData, bool) {
+	i := sort.Search(len(iconTable), func(i int) bool { return iconTable[i].name >= name })
+	if i == len(iconTable) || iconTable[i].name != name {
+		return nil, false
+	}
+	return &iconTable[i], true
+}
+
+// iconComponent renders an icon as an <svg> with attrs, wrapped in a <span>
+// with the icon class and spanAttrs when wrap is set. It is the single render
+// path of Icon, IconWithAttrs, IconSVG and IconSVGWithAttrs, and renders
+// nothing for unknown names.
+type iconComponent struct {
+	name      IconName
+	wrap      bool
+	spanAttrs templ.Attributes
+	attrs     templ.Attributes
+}
+
+// Render implements templ.Component
+func (c iconComponent) Render(ctx context.Context, w io.Writer) error {
+	icon, ok := lookupIcon(c.name)
+	if !ok {
+		return nil
+	}
+	var err error
+	write := func(s string) {
+		if err == nil {
+			_, err = io.WriteString(w, s)
+		}
+	}
+	attributes := func(attrs templ.Attributes) {
+		if err == nil && len(attrs) > 0 {
+			err = templ.RenderAttributes(ctx, w, attrs)
+		}
+	}
+
+	if c.wrap {
+		if len(c.spanAttrs) == 0 {
+			write("<span class=\"icon\">")
+		} else {
+			write("<span")
+			attributes(mergeClasses(c.spanAttrs))
+			write(">")
+		}
+	}
+	write("<svg viewBox=\"")
+	write(icon.viewBox)
+	write("\" fill=\"none\" stroke=\"currentColor\" stroke-width=\"2\" stroke-linecap=\"round\" stroke-linejoin=\"round\"")
+	attributes(c.attrs)
+	write(">")
+	write(icon.content)
+	write("</svg>")
+	if c.wrap {
+		write("</span>")
+	}
+	return err
+}
+
 // Icon renders any Lucide icon by name with type safety
-templ Icon(name IconName) {
-	switch name {
-{{range .Icons}}	case {{call $.ToConstantName .Name $.Prefix}}:
-		@{{.FuncName}}()
-{{end}}	}
+func Icon(name IconName) templ.Component {
+	return iconComponent{name: name, wrap: true}
 }
 
 // IconWithAttrs renders any Lucide icon by name with attributes
-templ IconWithAttrs(name IconName, attrs templ.Attributes) {
-	switch name {
-{{range .Icons}}	case {{call $.ToConstantName .Name $.Prefix}}:
-		@{{.FuncName}}WithAttrs(attrs)
-{{end}}	}
+func IconWithAttrs(name IconName, attrs templ.Attributes) templ.Component {
+	return iconComponent{name: name, wrap: true, spanAttrs: attrs}
 }
 
 // IconSVG renders any Lucide icon SVG by name with type safety
-templ IconSVG(name IconName) {
-	switch name {
-{{range .Icons}}	case {{call $.ToConstantName .Name $.Prefix}}:
-		@{{.FuncName}}SVG()
-{{end}}	}
+func IconSVG(name IconName) templ.Component {
+	return iconComponent{name: name}
 }
 
 // IconSVGWithAttrs renders any Lucide icon SVG by name with attributes
-templ IconSVGWithAttrs(name IconName, attrs templ.Attributes) {
-	switch name {
-{{range .Icons}}	case {{call $.ToConstantName .Name $.Prefix}}:
-		@{{.FuncName}}SVGWithAttrs(attrs)
-{{end}}	}
+func IconSVGWithAttrs(name IconName, attrs templ.Attributes) templ.Component {
+	return iconComponent{name: name, attrs: attrs}
 }
 
 // IconExists checks if an icon name is valid
 func IconExists(name string) bool {
-	switch IconName(name) {
-{{range .Icons}}	case {{call $.ToConstantName .Name $.Prefix}}:
-		return true
-{{end}}	default:
-		return false
-	}
+	_, ok := lookupIcon(IconName(name))
+	return ok
 }
 
 // AllIcons returns all available icon names
 func AllIcons() []IconName {
-	return []IconName{
-{{range .Icons}}		{{call $.ToConstantName .Name $.Prefix}},
-{{end}}	}
+	names := make([]IconName, len(iconTable))
+	for i, icon := range iconTable {
+		names[i] = icon.name
+	}
+	return names
 }
 
 // IconCount returns the total number of available icons
 func IconCount() int {
-	return {{len .Icons}}
+	return len(iconTable)
 }
 
 // IconByName returns the IconName for a string name if it exists
@@ -155,7 +232,7 @@ func IconByName(name string) (IconName, bool) {
 }`
 
 // Template for search functionality (should be a .go file, not .templ)
-const searchTemplate = `// Code generated by lucide-templ-gen on {{.Timestamp}}. DO NOT EDIT.
+const searchTemplate = generatedHeader + `
 
 package {{.PackageName}}
 
@@ -486,7 +563,7 @@ func (s *IconSearcher) SearchWithOptions(query string, options SearchOptions) []
 }`
 
 // Template for categorized icon access
-const categoriesTemplate = `// Code generated by lucide-templ-gen on {{.Timestamp}}. DO NOT EDIT.
+const categoriesTemplate = generatedHeader + `
 
 package {{.PackageName}}
 
@@ -528,25 +605,219 @@ func AllCategories() []string {
 {{end}}	}
 }`
 
+// Template for the SVG sprite sheet (a .go file, not .templ)
+const spriteTemplate = generatedHeader + `
+
+package {{.PackageName}}
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/a-h/templ"
+)
+
+// Sprite collects icons as the <symbol> elements of one SVG sprite sheet, so
+// a page that shows an icon many times carries its path data once. Icons are
+// added up front with NewSprite or Add, or as Use renders them. Sprites are
+// safe for concurrent use.
+type Sprite struct {
+	mu   sync.Mutex
+	used map[IconName]bool
+	url  string // URL the sheet is served at, or "" for an inline sheet
+}
+
+// NewSprite creates a sprite holding names
+func NewSprite(names ...IconName) *Sprite {
+	s := &Sprite{used: make(map[IconName]bool)}
+	s.Add(names...)
+	return s
+}
+
+// DefaultSprite holds the icons rendered by Use when the context carries no
+// sprite (see WithSprite)
+var DefaultSprite = NewSprite()
+
+// SpriteID returns the id of the icon's <symbol> in a sprite sheet
+func SpriteID(name IconName) string {
+	return "icon-" + string(name)
+}
+
+// Add adds icons to the sprite, ignoring unknown names
+func (s *Sprite) Add(names ...IconName) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, name := range names {
+		if IconExists(string(name)) {
+			s.used[name] = true
+		}
+	}
+}
+
+// Names returns the icons in the sprite in sorted order
+func (s *Sprite) Names() []IconName {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]IconName, 0, len(s.used))
+	for name := range s.used {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+// Len returns the number of icons in the sprite
+func (s *Sprite) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.used)
+}
+
+// SetURL makes Use reference the symbols in the sheet served at url, e.g.
+// by Handler, instead of an inline sheet rendered by Sheet
+func (s *Sprite) SetURL(url string) *Sprite {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.url = url
+	return s
+}
+
+// Use renders an icon from the sprite, adding it if needed. The markup
+// matches IconWithAttrs, with a <use> reference in place of the path data:
+//
+//	<span class="icon"><svg ...><use href="#icon-trash-2"></use></svg></span>
+//
+// Unknown names render nothing.
+func (s *Sprite) Use(name IconName, attrs templ.Attributes) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if !IconExists(string(name)) {
+			return nil
+		}
+		s.Add(name)
+		s.mu.Lock()
+		href := s.url + "#" + SpriteID(name)
+		s.mu.Unlock()
+
+		if _, err := io.WriteString(w, "<span"); err != nil {
+			return err
+		}
+		if err := templ.RenderAttributes(ctx, w, mergeClasses(attrs)); err != nil {
+			return err
+		}
+		_, err := io.WriteString(w, "><svg fill=\"none\" stroke=\"currentColor\" stroke-width=\"2\" stroke-linecap=\"round\" stroke-linejoin=\"round\">"+
+			"<use href=\""+templ.EscapeString(href)+"\"></use></svg></span>")
+		return err
+	})
+}
+
+// Use renders an icon from the sprite of the context, or DefaultSprite, and
+// records it there. Render the sprite's Sheet once on the page, e.g. at the
+// end of <body>, or serve it with Handler and SetURL.
+//
+//	@icon.Use(icon.IconTrash2, templ.Attributes{"class": "icon-sm"})
+func Use(name IconName, attrs templ.Attributes) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return SpriteFrom(ctx).Use(name, attrs).Render(ctx, w)
+	})
+}
+
+// Symbols returns the <symbol> elements of the sprite's icons
+func (s *Sprite) Symbols() string {
+	var symbols strings.Builder
+	for _, name := range s.Names() {
+		icon, _ := lookupIcon(name)
+		fmt.Fprintf(&symbols, "<symbol id=\"%s\" viewBox=\"%s\">%s</symbol>", SpriteID(name), icon.viewBox, icon.content)
+	}
+	return symbols.String()
+}
+
+// SVG returns the sprite as a standalone SVG document, as served by Handler
+func (s *Sprite) SVG() string {
+	return "<svg xmlns=\"http://www.w3.org/2000/svg\">" + s.Symbols() + "</svg>\n"
+}
+
+// Sheet returns a component rendering the sprite inline as a hidden <svg>.
+// Render it once per page after the icons that use it, e.g. at the end of
+// <body>, so the icons rendered by Use are included.
+func (s *Sprite) Sheet() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		symbols := s.Symbols()
+		if symbols == "" {
+			return nil
+		}
+		_, err := io.WriteString(w, "<svg xmlns=\"http://www.w3.org/2000/svg\" style=\"display:none\" aria-hidden=\"true\">"+symbols+"</svg>")
+		return err
+	})
+}
+
+// Handler returns an http.Handler that serves the sprite as an SVG document.
+// The content grows as Use adds icons, so clients revalidate it with an ETag
+// on every use.
+//
+//	http.Handle("/static/icons.svg", icon.DefaultSprite.SetURL("/static/icons.svg").Handler())
+func (s *Sprite) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		svg := s.SVG()
+		h := fnv.New64a()
+		io.WriteString(h, svg)
+		w.Header().Set("Content-Type", "image/svg+xml")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("ETag", fmt.Sprintf("\"%016x\"", h.Sum64()))
+		http.ServeContent(w, r, "icons.svg", time.Time{}, strings.NewReader(svg))
+	})
+}
+
+type spriteKey struct{}
+
+// WithSprite returns a context carrying sprite, for collecting the icons of
+// a single page:
+//
+//	sprite := icon.NewSprite()
+//	page.Render(icon.WithSprite(r.Context(), sprite), w)
+//
+// The layout then renders @icon.SpriteFrom(ctx).Sheet() at the end of <body>.
+func WithSprite(ctx context.Context, sprite *Sprite) context.Context {
+	return context.WithValue(ctx, spriteKey{}, sprite)
+}
+
+// SpriteFrom returns the sprite of ctx, or DefaultSprite if there is none
+func SpriteFrom(ctx context.Context) *Sprite {
+	if sprite, ok := ctx.Value(spriteKey{}).(*Sprite); ok {
+		return sprite
+	}
+	return DefaultSprite
+}
+`
+
 // TemplateData holds data for template execution
 type TemplateData struct {
-	PackageName    string
-	Prefix         string
-	Icons          []IconData
-	Categories     []string
-	Timestamp      string
-	ToConstantName func(string, string) string
-	ToCategoryName func(string) string
-	Join           func([]string, string) string
+	PackageName     string
+	Prefix          string
+	Icons           []IconData
+	Categories      []string
+	Version         string // Lucide version of the lockfile
+	Commit          string // Lucide commit of the lockfile
+	ToConstantName  func(string, string) string
+	ToCategoryName  func(string) string
+	RenderedContent func(string) string
+	Join            func([]string, string) string
 }
 
 // generateIconsFile creates the main icons template file
-func generateIconsFile(icons []IconData, config Config, outputPath string) error {
+func generateIconsFile(icons []IconData, config Config, lock *Lock, outputPath string) error {
 	data := TemplateData{
 		PackageName: config.PackageName,
 		Prefix:      config.Prefix,
 		Icons:       icons,
-		Timestamp:   time.Now().Format(time.RFC3339),
+		Version:     lock.Version,
+		Commit:      lock.Commit,
 		Join:        joinStrings,
 	}
 
@@ -562,29 +833,33 @@ func generateIconsFile(icons []IconData, config Config, outputPath string) error
 }
 
 // generateRegistryFile creates the icon registry file
-func generateRegistryFile(icons []IconData, config Config, outputPath string) error {
+func generateRegistryFile(icons []IconData, config Config, lock *Lock, outputPath string) error {
 	data := TemplateData{
-		PackageName:    config.PackageName,
-		Prefix:         config.Prefix,
-		Icons:          icons,
-		Timestamp:      time.Now().Format(time.RFC3339),
-		ToConstantName: toConstantName,
-		Join:           joinStrings,
+		PackageName:     config.PackageName,
+		Prefix:          config.Prefix,
+		Icons:           icons,
+		Version:         lock.Version,
+		Commit:          lock.Commit,
+		ToConstantName:  toConstantName,
+		RenderedContent: renderedContent,
+		Join:            joinStrings,
 	}
 
 	tmpl := template.Must(template.New("registry").Parse(registryTemplate))
 
-	file, err := os.Create(outputPath)
-	if err != nil {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
 		return err
 	}
-	defer file.Close()
-
-	return tmpl.Execute(file, data)
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format registry: %w", err)
+	}
+	return os.WriteFile(outputPath, source, 0644)
 }
 
 // generateCategoriesFile creates the categories file
-func generateCategoriesFile(icons []IconData, config Config, outputPath string) error {
+func generateCategoriesFile(icons []IconData, config Config, lock *Lock, outputPath string) error {
 	categories := getUniqueCategories(icons)
 
 	data := TemplateData{
@@ -592,7 +867,8 @@ func generateCategoriesFile(icons []IconData, config Config, outputPath string)
 		Prefix:         config.Prefix,
 		Icons:          icons,
 		Categories:     categories,
-		Timestamp:      time.Now().Format(time.RFC3339),
+		Version:        lock.Version,
+		Commit:         lock.Commit,
 		ToConstantName: toConstantName,
 		ToCategoryName: toCategoryName,
 		Join:           joinStrings,
@@ -627,18 +903,51 @@ func toCategoryName(category string) string {
 	return result.String()
 }
 
+var (
+	// startTagPattern matches a start or self-closing tag such as <path d="..." />
+	startTagPattern = regexp.MustCompile(`<([A-Za-z][\w-]*)([^<>]*?)\s*(/?)>`)
+
+	// attributePattern matches a quoted attribute of a tag
+	attributePattern = regexp.MustCompile(`([^\s=]+)\s*=\s*"([^"]*)"`)
+
+	// elementSpacePattern matches the whitespace between two tags
+	elementSpacePattern = regexp.MustCompile(`>\s+<`)
+)
+
+// renderedContent returns SVG markup in the form templ renders it, so the
+// registry table matches the output of the icon components: attributes are
+// separated by one space, self-closing elements get end tags and whitespace
+// between elements becomes one space
+func renderedContent(content string) string {
+	content = startTagPattern.ReplaceAllStringFunc(strings.TrimSpace(content), func(tag string) string {
+		m := startTagPattern.FindStringSubmatch(tag)
+		var rendered strings.Builder
+		rendered.WriteString("<" + m[1])
+		for _, attr := range attributePattern.FindAllStringSubmatch(m[2], -1) {
+			rendered.WriteString(" " + attr[1] + `="` + attr[2] + `"`)
+		}
+		rendered.WriteString(">")
+		if m[3] == "/" {
+			rendered.WriteString("</" + m[1] + ">")
+		}
+		return rendered.String()
+	})
+	return elementSpacePattern.ReplaceAllString(content, "> <")
+}
+
 // joinStrings joins a slice of strings with a separator
 func joinStrings(slice []string, sep string) string {
 	return strings.Join(slice, sep)
 }
 
 // generateSearchFile creates the search functionality file
-func generateSearchFile(icons []IconData, config Config, outputPath string) error {
+func generateSearchFile(icons []IconData, config Config, lock *Lock, outputPath string) error {
 	data := TemplateData{
 		PackageName:    config.PackageName,
 		Prefix:         config.Prefix,
 		Icons:          icons,
-		Timestamp:      time.Now().Format(time.RFC3339),
+		Version:        lock.Version,
+		Commit:         lock.Commit,
 		ToConstantName: toConstantName,
 		ToCategoryName: toCategoryName,
 		Join:           joinStrings,
@@ -655,4 +964,23 @@ func generateSearchFile(icons []IconData, config Config, outputPath string) erro
 	defer file.Close()
 
 	return tmpl.Execute(file, data)
-}
\ No newline at end of file
+}
+
+// generateSpriteFile creates the SVG sprite sheet file
+func generateSpriteFile(config Config, lock *Lock, outputPath string) error {
+	data := TemplateData{
+		PackageName: config.PackageName,
+		Version:     lock.Version,
+		Commit:      lock.Commit,
+	}
+
+	tmpl := template.Must(template.New("sprite").Parse(spriteTemplate))
+
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return tmpl.Execute(file, data)
+}
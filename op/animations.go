@@ -6,116 +6,116 @@ var Animation = &animation{}
 type animation struct{}
 
 // FadeIn returns the fade-in animation
-func (a *animation) FadeIn() string {
-	return cssVar("animation-fade-in")
+func (a *animation) FadeIn() AnimationValue {
+	return AnimationValue(cssVar("animation-fade-in"))
 }
 
 // FadeInBloom returns the fade-in-bloom animation
-func (a *animation) FadeInBloom() string {
-	return cssVar("animation-fade-in-bloom")
+func (a *animation) FadeInBloom() AnimationValue {
+	return AnimationValue(cssVar("animation-fade-in-bloom"))
 }
 
 // FadeOut returns the fade-out animation
-func (a *animation) FadeOut() string {
-	return cssVar("animation-fade-out")
+func (a *animation) FadeOut() AnimationValue {
+	return AnimationValue(cssVar("animation-fade-out"))
 }
 
 // FadeOutBloom returns the fade-out-bloom animation
-func (a *animation) FadeOutBloom() string {
-	return cssVar("animation-fade-out-bloom")
+func (a *animation) FadeOutBloom() AnimationValue {
+	return AnimationValue(cssVar("animation-fade-out-bloom"))
 }
 
 // ScaleUp returns the scale-up animation
-func (a *animation) ScaleUp() string {
-	return cssVar("animation-scale-up")
+func (a *animation) ScaleUp() AnimationValue {
+	return AnimationValue(cssVar("animation-scale-up"))
 }
 
 // ScaleDown returns the scale-down animation
-func (a *animation) ScaleDown() string {
-	return cssVar("animation-scale-down")
+func (a *animation) ScaleDown() AnimationValue {
+	return AnimationValue(cssVar("animation-scale-down"))
 }
 
 // SlideOutUp returns the slide-out-up animation
-func (a *animation) SlideOutUp() string {
-	return cssVar("animation-slide-out-up")
+func (a *animation) SlideOutUp() AnimationValue {
+	return AnimationValue(cssVar("animation-slide-out-up"))
 }
 
 // SlideOutDown returns the slide-out-down animation
-func (a *animation) SlideOutDown() string {
-	return cssVar("animation-slide-out-down")
+func (a *animation) SlideOutDown() AnimationValue {
+	return AnimationValue(cssVar("animation-slide-out-down"))
 }
 
 // SlideOutRight returns the slide-out-right animation
-func (a *animation) SlideOutRight() string {
-	return cssVar("animation-slide-out-right")
+func (a *animation) SlideOutRight() AnimationValue {
+	return AnimationValue(cssVar("animation-slide-out-right"))
 }
 
 // SlideOutLeft returns the slide-out-left animation
-func (a *animation) SlideOutLeft() string {
-	return cssVar("animation-slide-out-left")
+func (a *animation) SlideOutLeft() AnimationValue {
+	return AnimationValue(cssVar("animation-slide-out-left"))
 }
 
 // SlideInUp returns the slide-in-up animation
-func (a *animation) SlideInUp() string {
-	return cssVar("animation-slide-in-up")
+func (a *animation) SlideInUp() AnimationValue {
+	return AnimationValue(cssVar("animation-slide-in-up"))
 }
 
 // SlideInDown returns the slide-in-down animation
-func (a *animation) SlideInDown() string {
-	return cssVar("animation-slide-in-down")
+func (a *animation) SlideInDown() AnimationValue {
+	return AnimationValue(cssVar("animation-slide-in-down"))
 }
 
 // SlideInRight returns the slide-in-right animation
-func (a *animation) SlideInRight() string {
-	return cssVar("animation-slide-in-right")
+func (a *animation) SlideInRight() AnimationValue {
+	return AnimationValue(cssVar("animation-slide-in-right"))
 }
 
 // SlideInLeft returns the slide-in-left animation
-func (a *animation) SlideInLeft() string {
-	return cssVar("animation-slide-in-left")
+func (a *animation) SlideInLeft() AnimationValue {
+	return AnimationValue(cssVar("animation-slide-in-left"))
 }
 
 // ShakeX returns the shake-x animation
-func (a *animation) ShakeX() string {
-	return cssVar("animation-shake-x")
+func (a *animation) ShakeX() AnimationValue {
+	return AnimationValue(cssVar("animation-shake-x"))
 }
 
 // ShakeY returns the shake-y animation
-func (a *animation) ShakeY() string {
-	return cssVar("animation-shake-y")
+func (a *animation) ShakeY() AnimationValue {
+	return AnimationValue(cssVar("animation-shake-y"))
 }
 
 // ShakeZ returns the shake-z animation
-func (a *animation) ShakeZ() string {
-	return cssVar("animation-shake-z")
+func (a *animation) ShakeZ() AnimationValue {
+	return AnimationValue(cssVar("animation-shake-z"))
 }
 
 // Spin returns the spin animation
-func (a *animation) Spin() string {
-	return cssVar("animation-spin")
+func (a *animation) Spin() AnimationValue {
+	return AnimationValue(cssVar("animation-spin"))
 }
 
 // Ping returns the ping animation
-func (a *animation) Ping() string {
-	return cssVar("animation-ping")
+func (a *animation) Ping() AnimationValue {
+	return AnimationValue(cssVar("animation-ping"))
 }
 
 // Blink returns the blink animation
-func (a *animation) Blink() string {
-	return cssVar("animation-blink")
+func (a *animation) Blink() AnimationValue {
+	return AnimationValue(cssVar("animation-blink"))
 }
 
 // Float returns the float animation
-func (a *animation) Float() string {
-	return cssVar("animation-float")
+func (a *animation) Float() AnimationValue {
+	return AnimationValue(cssVar("animation-float"))
 }
 
 // Bounce returns the bounce animation
-func (a *animation) Bounce() string {
-	return cssVar("animation-bounce")
+func (a *animation) Bounce() AnimationValue {
+	return AnimationValue(cssVar("animation-bounce"))
 }
 
 // Pulse returns the pulse animation
-func (a *animation) Pulse() string {
-	return cssVar("animation-pulse")
+func (a *animation) Pulse() AnimationValue {
+	return AnimationValue(cssVar("animation-pulse"))
 }
\ No newline at end of file
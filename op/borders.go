@@ -8,40 +8,40 @@ var Border = &border{}
 type border struct{}
 
 // Size returns a border size variable (--border-size-{1-5})
-func (b *border) Size(n int) string {
+func (b *border) Size(n int) Length {
 	if n < 1 || n > 5 {
 		n = clamp(n, 1, 5)
 	}
-	return cssVar(fmt.Sprintf("border-size-%d", n))
+	return Length(cssVar(fmt.Sprintf("border-size-%d", n)))
 }
 
 // Radius returns a radius variable (--radius-{1-6})
-func Radius(n int) string {
+func Radius(n int) Length {
 	if n < 1 || n > 6 {
 		n = clamp(n, 1, 6)
 	}
-	return cssVar(fmt.Sprintf("radius-%d", n))
+	return Length(cssVar(fmt.Sprintf("radius-%d", n)))
 }
 
 // RadiusRound returns the round radius variable
-func RadiusRound() string {
-	return cssVar("radius-round")
+func RadiusRound() Length {
+	return Length(cssVar("radius-round"))
 }
 
 // RadiusBlob returns a blob radius variable (--radius-blob-{1-5})
-func RadiusBlob(n int) string {
+func RadiusBlob(n int) Length {
 	if n < 1 || n > 5 {
 		n = clamp(n, 1, 5)
 	}
-	return cssVar(fmt.Sprintf("radius-blob-%d", n))
+	return Length(cssVar(fmt.Sprintf("radius-blob-%d", n)))
 }
 
 // RadiusConditional returns a conditional radius variable (--radius-conditional-{1-6})
-func RadiusConditional(n int) string {
+func RadiusConditional(n int) Length {
 	if n < 1 || n > 6 {
 		n = clamp(n, 1, 6)
 	}
-	return cssVar(fmt.Sprintf("radius-conditional-%d", n))
+	return Length(cssVar(fmt.Sprintf("radius-conditional-%d", n)))
 }
 
 // Border size constants
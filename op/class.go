@@ -0,0 +1,160 @@
+package op
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/a-h/templ"
+)
+
+// Sheet collects the rules of classes generated from styles. A class is named
+// after a hash of its rules, so identical styles share one class and a
+// sheet holds each rule once, however often it is used. Sheets are safe for
+// concurrent use.
+type Sheet struct {
+	mu    sync.Mutex
+	rules map[string]string // Rule by class name
+	order []string          // Class names in registration order
+}
+
+// NewSheet creates an empty sheet
+func NewSheet() *Sheet {
+	return &Sheet{rules: make(map[string]string)}
+}
+
+// DefaultSheet holds the classes created by Class. It lives as long as the
+// process and never shrinks, so it is only for static styles, a fixed set
+// built in code such as button variants. Styles built from request data
+// belong in a per-request sheet (see WithSheet), or every distinct value
+// adds a rule for good.
+var DefaultSheet = NewSheet()
+
+// Class returns the hashed class of a static style, registered in
+// DefaultSheet. Prefer op.SheetFrom(ctx).Class, which collects the classes
+// of the current request (see WithSheet) and falls back to DefaultSheet.
+//
+//	var primaryButton = op.Class(buttonStyle("primary", 3))
+//
+// Serve the rules with DefaultSheet.Handler() or render them with
+// DefaultSheet.Style().
+func Class(style *Style) templ.CSSClass {
+	return DefaultSheet.Class(style)
+}
+
+// Class registers the rules of style, including its nested blocks, and
+// returns its class. An empty style still gets a class but adds no rule.
+// Should two different styles hash to the same name, the later one gets a
+// numbered suffix rather than the rules of the first.
+func (sh *Sheet) Class(style *Style) templ.CSSClass {
+	h := fnv.New64a()
+	io.WriteString(h, strings.Join(style.rules("&"), "\n"))
+	base := fmt.Sprintf("op-%016x", h.Sum64())
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	name := base
+	for n := 2; ; n++ {
+		rules := strings.Join(style.rules("."+name), "\n")
+		existing, ok := sh.rules[name]
+		if !ok {
+			if rules != "" {
+				sh.rules[name] = rules
+				sh.order = append(sh.order, name)
+			}
+			break
+		}
+		if existing == rules {
+			break
+		}
+		name = fmt.Sprintf("%s-%d", base, n)
+	}
+	// A ComponentCSSClass without rules renders as a plain class name; the
+	// rule itself is emitted by the sheet rather than inline by templ
+	return templ.ComponentCSSClass{ID: name}
+}
+
+// Len returns the number of rules in the sheet
+func (sh *Sheet) Len() int {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return len(sh.order)
+}
+
+// CSS returns the rules in registration order, one per line
+func (sh *Sheet) CSS() string {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	var css strings.Builder
+	for _, name := range sh.order {
+		css.WriteString(sh.rules[name] + "\n")
+	}
+	return css.String()
+}
+
+// Style returns a component rendering the rules as a single <style> block,
+// with the nonce set by templ.WithNonce for Content-Security-Policy. Render
+// it after the components that use the sheet, e.g. at the end of <body>, so
+// their classes are registered.
+func (sh *Sheet) Style() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		css := sh.CSS()
+		if css == "" {
+			return nil
+		}
+		tag := "<style"
+		if nonce := templ.GetNonce(ctx); nonce != "" {
+			tag += ` nonce="` + templ.EscapeString(nonce) + `"`
+		}
+		// Declarations, selectors and conditions are validated when added, so
+		// the rules cannot contain "</"
+		_, err := io.WriteString(w, tag+">\n"+css+"</style>")
+		return err
+	})
+}
+
+// Handler returns an http.Handler that serves the rules as a stylesheet.
+// The content grows as classes are registered, so clients revalidate it
+// with an ETag on every use. A browser may fetch the stylesheet before the
+// page registering a class has rendered, so serve only sheets whose classes
+// are registered at init.
+//
+//	http.Handle("/static/op.css", op.DefaultSheet.Handler())
+func (sh *Sheet) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		css := sh.CSS()
+		h := fnv.New64a()
+		io.WriteString(h, css)
+		w.Header().Set("Content-Type", "text/css; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("ETag", fmt.Sprintf(`"%016x"`, h.Sum64()))
+		http.ServeContent(w, r, "op.css", time.Time{}, strings.NewReader(css))
+	})
+}
+
+type sheetKey struct{}
+
+// WithSheet returns a context carrying sheet, for collecting the classes of
+// a single request. This is the default way to use classes: the sheet holds
+// exactly what the page uses and is dropped with the request.
+//
+//	page.Render(op.WithSheet(r.Context(), op.NewSheet()), w)
+//
+// Components then use op.SheetFrom(ctx).Class(style) and the layout renders
+// @op.SheetFrom(ctx).Style() at the end of <body>.
+func WithSheet(ctx context.Context, sheet *Sheet) context.Context {
+	return context.WithValue(ctx, sheetKey{}, sheet)
+}
+
+// SheetFrom returns the sheet of ctx, or DefaultSheet if there is none
+func SheetFrom(ctx context.Context) *Sheet {
+	if sheet, ok := ctx.Value(sheetKey{}).(*Sheet); ok {
+		return sheet
+	}
+	return DefaultSheet
+}
@@ -0,0 +1,239 @@
+package op
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// RGBA is a literal sRGB color with channels and alpha in [0, 1]. It does
+// the color math in Go; the ColorValue methods below emit the equivalent
+// CSS expressions for values that are only known in the browser, such as
+// theme variables.
+type RGBA struct {
+	R, G, B, A float64
+}
+
+// ParseColor parses a hex (#rgb, #rgba, #rrggbb, #rrggbbaa), rgb(), hsl()
+// or oklch() color, in the comma or space separated syntax, as well as
+// black, white and transparent
+func ParseColor(s string) (RGBA, error) {
+	c, alpha, err := parseColorAlpha(s)
+	if err != nil {
+		return RGBA{}, err
+	}
+	return RGBA{c.r, c.g, c.b, alpha}, nil
+}
+
+// OKLCH returns the color with OKLCH lightness [0, 1], chroma and hue in
+// degrees, reducing chroma until it fits sRGB
+func OKLCH(l, c, h float64) RGBA {
+	rgb := oklch{l: math.Max(0, math.Min(1, l)), c: math.Max(0, c), h: h}.toGamut()
+	return RGBA{rgb.r, rgb.g, rgb.b, 1}.clamped()
+}
+
+// HSL returns the color with hue in degrees and saturation and lightness in [0, 1]
+func HSL(h, s, l float64) RGBA {
+	rgb := hslToSRGB(h, math.Max(0, math.Min(1, s)), math.Max(0, math.Min(1, l)))
+	return RGBA{rgb.r, rgb.g, rgb.b, 1}
+}
+
+func (c RGBA) srgb() srgb {
+	return srgb{c.R, c.G, c.B}
+}
+
+// clamped limits the channels to [0, 1]
+func (c RGBA) clamped() RGBA {
+	limit := func(v float64) float64 { return math.Max(0, math.Min(1, v)) }
+	return RGBA{limit(c.R), limit(c.G), limit(c.B), limit(c.A)}
+}
+
+// withOKLCH replaces the color, keeping its alpha
+func (c RGBA) withOKLCH(l, ch, h float64) RGBA {
+	out := OKLCH(l, ch, h)
+	out.A = c.A
+	return out
+}
+
+// ToOKLCH returns the OKLCH lightness, chroma and hue
+func (c RGBA) ToOKLCH() (l, ch, h float64) {
+	v := c.srgb().toOKLCH()
+	return v.l, v.c, v.h
+}
+
+// ToHSL returns the HSL hue in degrees and saturation and lightness in [0, 1]
+func (c RGBA) ToHSL() (h, s, l float64) {
+	return c.srgb().toHSL()
+}
+
+// Lighten raises the OKLCH lightness by amount (0-1)
+func (c RGBA) Lighten(amount float64) RGBA {
+	l, ch, h := c.ToOKLCH()
+	return c.withOKLCH(l+amount, ch, h)
+}
+
+// Darken lowers the OKLCH lightness by amount (0-1)
+func (c RGBA) Darken(amount float64) RGBA {
+	return c.Lighten(-amount)
+}
+
+// Saturate raises the OKLCH chroma by amount, e.g. 0.05
+func (c RGBA) Saturate(amount float64) RGBA {
+	l, ch, h := c.ToOKLCH()
+	return c.withOKLCH(l, ch+amount, h)
+}
+
+// Desaturate lowers the OKLCH chroma by amount, down to gray
+func (c RGBA) Desaturate(amount float64) RGBA {
+	return c.Saturate(-amount)
+}
+
+// WithAlpha returns the color with alpha set to a (0-1)
+func (c RGBA) WithAlpha(a float64) RGBA {
+	c.A = math.Max(0, math.Min(1, a))
+	return c
+}
+
+// Mix blends amount (0-1) of other into c in the OKLab space with
+// premultiplied alpha, matching color-mix(in oklab, c, other amount*100%)
+func (c RGBA) Mix(other RGBA, amount float64) RGBA {
+	amount = math.Max(0, math.Min(1, amount))
+	lab := func(c RGBA) (float64, float64, float64) {
+		v := c.srgb().toOKLCH()
+		hr := v.h * math.Pi / 180
+		return v.l * c.A, v.c * math.Cos(hr) * c.A, v.c * math.Sin(hr) * c.A
+	}
+	l1, a1, b1 := lab(c)
+	l2, a2, b2 := lab(other)
+	alpha := c.A*(1-amount) + other.A*amount
+	if alpha == 0 {
+		return RGBA{}
+	}
+	l := (l1*(1-amount) + l2*amount) / alpha
+	a := (a1*(1-amount) + a2*amount) / alpha
+	b := (b1*(1-amount) + b2*amount) / alpha
+	h := math.Atan2(b, a) * 180 / math.Pi
+	return RGBA{A: alpha}.withOKLCH(l, math.Hypot(a, b), h)
+}
+
+// Hex formats the color as #rrggbb, or #rrggbbaa if it is translucent
+func (c RGBA) Hex() ColorValue {
+	c = c.clamped()
+	hex := c.srgb().hex()
+	if c.A < 1 {
+		hex += fmt.Sprintf("%02x", int(math.Round(c.A*255)))
+	}
+	return ColorValue(hex)
+}
+
+// RGB formats the color as rgb(r g b) or rgb(r g b / a)
+func (c RGBA) RGB() ColorValue {
+	c = c.clamped()
+	return ColorValue(fmt.Sprintf("rgb(%s %s %s%s)",
+		formatNumber(c.R*255, 0), formatNumber(c.G*255, 0), formatNumber(c.B*255, 0), c.alpha()))
+}
+
+// HSL formats the color as hsl(h s% l%) or hsl(h s% l% / a)
+func (c RGBA) HSL() ColorValue {
+	h, s, l := c.clamped().ToHSL()
+	return ColorValue(fmt.Sprintf("hsl(%s %s%% %s%%%s)",
+		formatNumber(h, 1), formatNumber(s*100, 1), formatNumber(l*100, 1), c.alpha()))
+}
+
+// OKLCH formats the color as oklch(l c h) or oklch(l c h / a)
+func (c RGBA) OKLCH() ColorValue {
+	l, ch, h := c.clamped().ToOKLCH()
+	if ch < 1e-4 {
+		ch, h = 0, 0
+	}
+	return ColorValue(fmt.Sprintf("oklch(%s %s %s%s)",
+		formatNumber(l, 4), formatNumber(ch, 4), formatNumber(h, 2), c.alpha()))
+}
+
+// String returns the Hex form
+func (c RGBA) String() string {
+	return string(c.Hex())
+}
+
+// alpha returns the " / a" suffix of the functional notations
+func (c RGBA) alpha() string {
+	if c.A >= 1 {
+		return ""
+	}
+	return " / " + formatNumber(math.Max(0, c.A), 3)
+}
+
+// formatNumber rounds v to places decimals without trailing zeros
+func formatNumber(v float64, places int) string {
+	p := math.Pow(10, float64(places))
+	v = math.Round(v*p) / p
+	if v == 0 {
+		v = 0 // Avoid "-0"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// percent formats an amount in [0, 1] as a CSS percentage
+func percent(amount float64) string {
+	return formatNumber(math.Max(0, math.Min(1, amount))*100, 2) + "%"
+}
+
+// RGBA resolves the color in the light theme and parses it, so that color
+// math can run on theme tokens: op.Color.Blue(6).RGBA()
+func (v ColorValue) RGBA() (RGBA, error) {
+	return resolveColor(v, Resolve[ColorValue])
+}
+
+// RGBADark resolves the color in the dark theme and parses it
+func (v ColorValue) RGBADark() (RGBA, error) {
+	return resolveColor(v, ResolveDark[ColorValue])
+}
+
+// resolveColor parses v, substituting a token reference with its value
+func resolveColor(v ColorValue, resolve func(ColorValue) (string, bool)) (RGBA, error) {
+	value := string(v)
+	if resolved, ok := resolve(v); ok {
+		value = resolved
+	}
+	return ParseColor(value)
+}
+
+// Alpha returns the color at opacity a (0-1) as a color-mix() expression,
+// which works on theme variables in both themes:
+//
+//	op.Color.Blue(6).Alpha(0.3) // color-mix(in oklab, var(--blue-6) 30%, transparent)
+func (v ColorValue) Alpha(a float64) ColorValue {
+	return ColorValue(fmt.Sprintf("color-mix(in oklab, %s %s, transparent)", v, percent(a)))
+}
+
+// Mix blends amount (0-1) of other into the color with color-mix()
+func (v ColorValue) Mix(other ColorValue, amount float64) ColorValue {
+	return ColorValue(fmt.Sprintf("color-mix(in oklab, %s, %s %s)", v, other, percent(amount)))
+}
+
+// Lighten raises the OKLCH lightness by amount (0-1) with relative color
+// syntax: oklch(from var(--blue-6) calc(l + 0.1) c h)
+func (v ColorValue) Lighten(amount float64) ColorValue {
+	return v.relative(fmt.Sprintf("calc(l + %s) c h", formatNumber(amount, 4)))
+}
+
+// Darken lowers the OKLCH lightness by amount (0-1) with relative color syntax
+func (v ColorValue) Darken(amount float64) ColorValue {
+	return v.relative(fmt.Sprintf("calc(l - %s) c h", formatNumber(amount, 4)))
+}
+
+// Saturate raises the OKLCH chroma by amount with relative color syntax
+func (v ColorValue) Saturate(amount float64) ColorValue {
+	return v.relative(fmt.Sprintf("l calc(c + %s) h", formatNumber(amount, 4)))
+}
+
+// Desaturate lowers the OKLCH chroma by amount with relative color syntax,
+// down to gray
+func (v ColorValue) Desaturate(amount float64) ColorValue {
+	return v.relative(fmt.Sprintf("l max(0, calc(c - %s)) h", formatNumber(amount, 4)))
+}
+
+// relative returns an oklch() relative color based on v
+func (v ColorValue) relative(channels string) ColorValue {
+	return ColorValue(fmt.Sprintf("oklch(from %s %s)", v, channels))
+}
@@ -0,0 +1,53 @@
+// Code generated by cmd/generate-tokens from src/tokens.css. DO NOT EDIT.
+
+package op
+
+// Background returns the background theme color (--background)
+func (c *colors) Background() ColorValue {
+	return ColorValue(cssVar("background"))
+}
+
+// Border returns the border theme color (--border)
+func (c *colors) Border() ColorValue {
+	return ColorValue(cssVar("border"))
+}
+
+// Primary returns the primary theme color (--primary)
+func (c *colors) Primary() ColorValue {
+	return ColorValue(cssVar("primary"))
+}
+
+// PrimaryHover returns the primary-hover theme color (--primary-hover)
+func (c *colors) PrimaryHover() ColorValue {
+	return ColorValue(cssVar("primary-hover"))
+}
+
+// Shadow returns the shadow theme color (--shadow)
+func (c *colors) Shadow() ColorValue {
+	return ColorValue(cssVar("shadow"))
+}
+
+// ShadowLg returns the shadow-lg theme color (--shadow-lg)
+func (c *colors) ShadowLg() ColorValue {
+	return ColorValue(cssVar("shadow-lg"))
+}
+
+// Surface returns the surface theme color (--surface)
+func (c *colors) Surface() ColorValue {
+	return ColorValue(cssVar("surface"))
+}
+
+// SurfaceAlt returns the surface-alt theme color (--surface-alt)
+func (c *colors) SurfaceAlt() ColorValue {
+	return ColorValue(cssVar("surface-alt"))
+}
+
+// Text returns the text theme color (--text)
+func (c *colors) Text() ColorValue {
+	return ColorValue(cssVar("text"))
+}
+
+// TextMuted returns the text-muted theme color (--text-muted)
+func (c *colors) TextMuted() ColorValue {
+	return ColorValue(cssVar("text-muted"))
+}
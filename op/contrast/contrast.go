@@ -0,0 +1,169 @@
+// Package contrast checks the contrast of text and background colors with
+// the WCAG 2.x ratio and the APCA lightness contrast (Lc), over the values
+// the op package resolves for the light and dark themes.
+package contrast
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/riclib/open-props-css/op"
+)
+
+// WCAG 2.x minimum ratios
+const (
+	AA       = 4.5 // Normal text, level AA
+	AALarge  = 3.0 // Large text (24px, or 18.66px bold) and UI components, level AA
+	AAA      = 7.0 // Normal text, level AAA
+	AAALarge = 4.5 // Large text, level AAA
+)
+
+// Theme selects the token values used to resolve a color
+type Theme string
+
+const (
+	Light Theme = "light"
+	Dark  Theme = "dark"
+)
+
+// Themes lists both themes in audit order
+var Themes = []Theme{Light, Dark}
+
+// Ratio returns the WCAG 2.x contrast ratio of text on background, from 1
+// to 21. A translucent text color is blended over the background first.
+func Ratio(text, background op.RGBA) float64 {
+	l1 := luminance(blend(text, background))
+	l2 := luminance(background)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// Level names the highest WCAG level a ratio meets for normal text:
+// "AAA", "AA", "AA Large" or "Fail"
+func Level(ratio float64) string {
+	switch {
+	case ratio >= AAA:
+		return "AAA"
+	case ratio >= AA:
+		return "AA"
+	case ratio >= AALarge:
+		return "AA Large"
+	}
+	return "Fail"
+}
+
+// APCA returns the APCA lightness contrast (Lc, APCA-W3 0.0.98G) of text on
+// background: positive for dark text on a light background, negative for
+// light text on a dark one. An |Lc| of 75 is the suggested minimum for body
+// text, 60 for other content text and 45 for large headings.
+func APCA(text, background op.RGBA) float64 {
+	const (
+		blackThreshold = 0.022
+		blackClamp     = 1.414
+		scale          = 1.14
+		offset         = 0.027
+		lowClip        = 0.1
+		deltaYMin      = 0.0005
+	)
+	screenY := func(c op.RGBA) float64 {
+		y := 0.2126729*math.Pow(c.R, 2.4) + 0.7151522*math.Pow(c.G, 2.4) + 0.0721750*math.Pow(c.B, 2.4)
+		if y < blackThreshold {
+			y += math.Pow(blackThreshold-y, blackClamp)
+		}
+		return y
+	}
+
+	txt, bg := screenY(blend(text, background)), screenY(background)
+	if math.Abs(bg-txt) < deltaYMin {
+		return 0
+	}
+	if bg > txt {
+		// Dark text on a light background
+		sapc := (math.Pow(bg, 0.56) - math.Pow(txt, 0.57)) * scale
+		if sapc < lowClip {
+			return 0
+		}
+		return (sapc - offset) * 100
+	}
+	// Light text on a dark background
+	sapc := (math.Pow(bg, 0.65) - math.Pow(txt, 0.62)) * scale
+	if sapc > -lowClip {
+		return 0
+	}
+	return (sapc + offset) * 100
+}
+
+// Resolve parses a CSS color value in a theme. It substitutes var()
+// references with the token values resolved by op, picks the matching side
+// of light-dark() and accepts every literal op.ParseColor does.
+func Resolve(value string, theme Theme) (op.RGBA, error) {
+	value = strings.TrimSpace(value)
+	for range 8 {
+		if inner, ok := strings.CutPrefix(value, "light-dark("); ok && strings.HasSuffix(inner, ")") {
+			light, dark, ok := splitArgs(strings.TrimSuffix(inner, ")"))
+			if !ok {
+				return op.RGBA{}, fmt.Errorf("contrast: invalid light-dark() value %q", value)
+			}
+			value = light
+			if theme == Dark {
+				value = dark
+			}
+			continue
+		}
+		if strings.HasPrefix(value, "var(") {
+			resolve := op.Resolve[string]
+			if theme == Dark {
+				resolve = op.ResolveDark[string]
+			}
+			resolved, ok := resolve(value)
+			if !ok {
+				return op.RGBA{}, fmt.Errorf("contrast: unknown token %s", value)
+			}
+			value = strings.TrimSpace(resolved)
+			continue
+		}
+		return op.ParseColor(value)
+	}
+	return op.RGBA{}, fmt.Errorf("contrast: too many indirections resolving %q", value)
+}
+
+// splitArgs splits the two arguments of a function at the top-level comma
+func splitArgs(s string) (string, string, bool) {
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				return strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:]), true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// blend composites a translucent color over an opaque background
+func blend(c, background op.RGBA) op.RGBA {
+	if c.A >= 1 {
+		return c
+	}
+	mix := func(a, b float64) float64 { return a*c.A + b*(1-c.A) }
+	return op.RGBA{R: mix(c.R, background.R), G: mix(c.G, background.G), B: mix(c.B, background.B), A: 1}
+}
+
+// luminance returns the WCAG relative luminance of an sRGB color
+func luminance(c op.RGBA) float64 {
+	linear := func(v float64) float64 {
+		if v <= 0.04045 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(c.R) + 0.7152*linear(c.G) + 0.0722*linear(c.B)
+}
@@ -0,0 +1,92 @@
+package contrast
+
+import (
+	"math"
+	"testing"
+
+	"github.com/riclib/open-props-css/op"
+)
+
+func mustParse(t *testing.T, s string) op.RGBA {
+	t.Helper()
+	c, err := op.ParseColor(s)
+	if err != nil {
+		t.Fatalf("ParseColor(%q) error: %v", s, err)
+	}
+	return c
+}
+
+func TestRatio(t *testing.T) {
+	tests := []struct {
+		text, background string
+		ratio            float64
+		level            string
+	}{
+		{"#000", "#fff", 21, "AAA"},
+		{"#fff", "#000", 21, "AAA"},
+		{"#777", "#fff", 4.48, "AA Large"},
+		{"#767676", "#fff", 4.54, "AA"},
+		{"#888", "#fff", 3.54, "AA Large"},
+		{"#fff", "#fff", 1, "Fail"},
+		{"rgb(0 0 0 / 50%)", "#fff", 3.98, "AA Large"}, // Blended to mid gray
+	}
+	for _, test := range tests {
+		ratio := Ratio(mustParse(t, test.text), mustParse(t, test.background))
+		if math.Abs(ratio-test.ratio) > 0.01 {
+			t.Errorf("Ratio(%q, %q) = %.2f, want %.2f", test.text, test.background, ratio, test.ratio)
+		}
+		if level := Level(ratio); level != test.level {
+			t.Errorf("Level(Ratio(%q, %q)) = %q, want %q", test.text, test.background, level, test.level)
+		}
+	}
+}
+
+func TestAPCA(t *testing.T) {
+	tests := []struct {
+		text, background string
+		lc               float64
+	}{
+		{"#000", "#fff", 106.04},
+		{"#fff", "#000", -107.88},
+		{"#888", "#fff", 63.06},
+		{"#fff", "#888", -68.54},
+		{"#fff", "#fff", 0},
+	}
+	for _, test := range tests {
+		lc := APCA(mustParse(t, test.text), mustParse(t, test.background))
+		if math.Abs(lc-test.lc) > 0.01 {
+			t.Errorf("APCA(%q, %q) = %.2f, want %.2f", test.text, test.background, lc, test.lc)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		value    string
+		theme    Theme
+		expected string
+	}{
+		{"white", Light, "#ffffff"},
+		{"#0066cc", Dark, "#0066cc"},
+		{"var(--primary)", Light, "#0066cc"},
+		{"var(--primary)", Dark, "#3b82f6"},
+		{"light-dark(var(--red-9), var(--red-1))", Light, "#c92a2a"},
+		{"light-dark(var(--red-9), var(--red-1))", Dark, "#ffe3e3"},
+		{"light-dark(rgb(0, 0, 0), #fff)", Dark, "#ffffff"},
+	}
+	for _, test := range tests {
+		c, err := Resolve(test.value, test.theme)
+		if err != nil {
+			t.Errorf("Resolve(%q, %s) error: %v", test.value, test.theme, err)
+			continue
+		}
+		if result := c.String(); result != test.expected {
+			t.Errorf("Resolve(%q, %s) = %q, want %q", test.value, test.theme, result, test.expected)
+		}
+	}
+	for _, value := range []string{"var(--no-such-token)", "light-dark(#fff)", "currentColor", "inherit"} {
+		if _, err := Resolve(value, Light); err == nil {
+			t.Errorf("Resolve(%q) should fail", value)
+		}
+	}
+}
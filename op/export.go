@@ -0,0 +1,286 @@
+package op
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TokenFormat selects the JSON flavour written by ExportTokens
+type TokenFormat string
+
+// Supported token export formats
+const (
+	FormatDTCG            TokenFormat = "dtcg"             // W3C Design Tokens Community Group format
+	FormatStyleDictionary TokenFormat = "style-dictionary" // Style Dictionary (value/type/darkValue)
+	FormatTokensStudio    TokenFormat = "tokens-studio"    // Tokens Studio for Figma token sets and themes
+)
+
+// TokenFormats lists every supported export format
+var TokenFormats = []TokenFormat{FormatDTCG, FormatStyleDictionary, FormatTokensStudio}
+
+// dtcgExtension namespaces the dark theme values in DTCG $extensions
+const dtcgExtension = "com.github.riclib.open-props-css"
+
+// Token groups used by every format
+const (
+	groupPrimitive = "primitive"
+	groupSemantic  = "semantic"
+	groupDark      = "dark"
+)
+
+var (
+	dimensionPattern = regexp.MustCompile(`^-?(\d+\.?\d*|\.\d+)(px|rem|em|ch|ex|vw|vh|vi|vb|dvh|svh|lvh)$`)
+	durationPattern  = regexp.MustCompile(`^(\d+\.?\d*|\.\d+)(ms|s)$`)
+	numberPattern    = regexp.MustCompile(`^-?(\d+\.?\d*|\.\d+)$`)
+)
+
+// exportToken is a single token prepared for export
+type exportToken struct {
+	name     string
+	kind     string // DTCG $type, empty when no type fits
+	light    string
+	dark     string
+	alias    string // Referenced token path such as "primitive.size-4", if the token is an alias
+	semantic bool
+}
+
+// group returns the top-level group the token belongs to
+func (t exportToken) group() string {
+	if t.semantic {
+		return groupSemantic
+	}
+	return groupPrimitive
+}
+
+// ExportTokens writes the complete token set, Open Props primitives and the
+// semantic theme tokens, as JSON in the given format. Values are literal (see
+// Resolve); tokens whose value changes in the dark theme carry both values.
+// DTCG keeps aliases such as --container-padding: var(--size-4) as
+// references ({primitive.size-4}), so an import restores the var().
+func ExportTokens(w io.Writer, format TokenFormat) error {
+	var doc any
+	switch format {
+	case FormatDTCG:
+		doc = exportDTCG()
+	case FormatStyleDictionary:
+		doc = exportStyleDictionary()
+	case FormatTokensStudio:
+		doc = exportTokensStudio()
+	default:
+		return fmt.Errorf("op: unknown token format %q", format)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// exportTokens returns every token in sorted order
+func exportTokens() []exportToken {
+	var tokens []exportToken
+	for _, name := range Tokens() {
+		light, _ := Resolve(name)
+		dark, _ := ResolveDark(name)
+		tokens = append(tokens, exportToken{
+			name:     name,
+			kind:     tokenType(name, light),
+			light:    light,
+			dark:     dark,
+			alias:    aliasPath(name),
+			semantic: semanticTokens[name],
+		})
+	}
+	return tokens
+}
+
+// aliasPath returns the group and name of the token that name is an alias
+// for, or "" if it is not an alias of an exported token
+func aliasPath(name string) string {
+	ref, ok := tokenAliases[name]
+	if !ok {
+		return ""
+	}
+	if semanticTokens[ref] {
+		return groupSemantic + "." + ref
+	}
+	return groupPrimitive + "." + ref
+}
+
+// tokenType infers the DTCG $type of a token from its name and value
+func tokenType(name, value string) string {
+	switch {
+	case strings.HasPrefix(name, "font-weight-"):
+		return "fontWeight"
+	case strings.HasPrefix(name, "font-") && !isFontMetric(name):
+		return "fontFamily"
+	case strings.HasPrefix(value, "cubic-bezier("):
+		return "cubicBezier"
+	case dimensionPattern.MatchString(value):
+		return "dimension"
+	case durationPattern.MatchString(value):
+		return "duration"
+	case numberPattern.MatchString(value):
+		return "number"
+	}
+	if _, err := parseColor(value); err == nil {
+		return "color"
+	}
+	return ""
+}
+
+// isFontMetric reports whether a font- token is a size, line height or
+// letter spacing rather than a font family
+func isFontMetric(name string) bool {
+	for _, prefix := range []string{"font-size-", "font-lineheight-", "font-letterspacing-"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// dtcgValue converts a CSS value to the DTCG representation of its type
+func dtcgValue(kind, value string) any {
+	switch kind {
+	case "fontWeight", "number":
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			return n
+		}
+	case "fontFamily":
+		var families []string
+		for _, family := range splitList(value) {
+			families = append(families, strings.Trim(family, `"'`))
+		}
+		return families
+	case "cubicBezier":
+		args := splitList(strings.TrimSuffix(strings.TrimPrefix(value, "cubic-bezier("), ")"))
+		points := make([]float64, 0, len(args))
+		for _, arg := range args {
+			n, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				return value
+			}
+			points = append(points, n)
+		}
+		return points
+	}
+	return value
+}
+
+// splitList splits a comma separated CSS list and trims each item
+func splitList(s string) []string {
+	items := strings.Split(s, ",")
+	for i := range items {
+		items[i] = strings.TrimSpace(items[i])
+	}
+	return items
+}
+
+// exportDTCG groups tokens into primitive and semantic groups; dark values
+// are stored under $extensions since the format has no notion of modes.
+// Aliases are references, which resolve in either theme.
+func exportDTCG() map[string]any {
+	doc := map[string]any{
+		groupPrimitive: map[string]any{},
+		groupSemantic:  map[string]any{},
+	}
+	for _, t := range exportTokens() {
+		token := map[string]any{"$value": dtcgValue(t.kind, t.light)}
+		if t.alias != "" {
+			token["$value"] = "{" + t.alias + "}"
+		}
+		if t.kind != "" {
+			token["$type"] = t.kind
+		}
+		if t.dark != t.light && t.alias == "" {
+			token["$extensions"] = map[string]any{
+				dtcgExtension: map[string]any{"dark": dtcgValue(t.kind, t.dark)},
+			}
+		}
+		doc[t.group()].(map[string]any)[t.name] = token
+	}
+	return doc
+}
+
+// exportStyleDictionary uses the Style Dictionary value/type attributes and
+// the darkValue attribute for tokens that change in the dark theme
+func exportStyleDictionary() map[string]any {
+	doc := map[string]any{
+		groupPrimitive: map[string]any{},
+		groupSemantic:  map[string]any{},
+	}
+	for _, t := range exportTokens() {
+		token := map[string]any{"value": t.light}
+		if t.kind != "" {
+			token["type"] = t.kind
+		}
+		if t.dark != t.light {
+			token["darkValue"] = t.dark
+		}
+		doc[t.group()].(map[string]any)[t.name] = token
+	}
+	return doc
+}
+
+// studioTypes maps DTCG types to Tokens Studio types
+var studioTypes = map[string]string{
+	"color":      "color",
+	"dimension":  "dimension",
+	"fontWeight": "fontWeights",
+	"fontFamily": "fontFamilies",
+	"number":     "number",
+}
+
+// exportTokensStudio writes one token set per group plus a dark set holding
+// the dark values, and light and dark themes combining them
+func exportTokensStudio() map[string]any {
+	sets := map[string]map[string]any{
+		groupPrimitive: {},
+		groupSemantic:  {},
+		groupDark:      {},
+	}
+	for _, t := range exportTokens() {
+		kind := studioTypes[t.kind]
+		if kind == "" {
+			kind = "other"
+		}
+		sets[t.group()][t.name] = map[string]any{"value": t.light, "type": kind}
+		if t.dark != t.light {
+			sets[groupDark][t.name] = map[string]any{"value": t.dark, "type": kind}
+		}
+	}
+
+	doc := map[string]any{
+		"$metadata": map[string]any{
+			"tokenSetOrder": []string{groupPrimitive, groupSemantic, groupDark},
+		},
+		"$themes": []map[string]any{
+			{
+				"id":   "light",
+				"name": "Light",
+				"selectedTokenSets": map[string]string{
+					groupPrimitive: "enabled",
+					groupSemantic:  "enabled",
+				},
+			},
+			{
+				"id":   "dark",
+				"name": "Dark",
+				"selectedTokenSets": map[string]string{
+					groupPrimitive: "enabled",
+					groupSemantic:  "enabled",
+					groupDark:      "enabled",
+				},
+			},
+		},
+	}
+	for name, set := range sets {
+		doc[name] = set
+	}
+	return doc
+}
@@ -0,0 +1,191 @@
+package op
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MediaQuery is a media query condition, e.g. "(width >= 768px)"
+type MediaQuery string
+
+func (q MediaQuery) String() string { return string(q) }
+
+// And combines two conditions: op.MediaMDOnly.And(op.MediaLandscape)
+func (q MediaQuery) And(other MediaQuery) MediaQuery {
+	return q.group() + " and " + other.group()
+}
+
+// group parenthesizes an "or" condition so it can be combined with "and"
+func (q MediaQuery) group() MediaQuery {
+	if strings.Contains(string(q), " or ") {
+		return "(" + q + ")"
+	}
+	return q
+}
+
+// Open Props custom media, resolved to plain media queries because browsers
+// do not support @custom-media. Each breakpoint has an -only range and the
+// n-above and n-below halves split at its upper bound, as in Open Props
+// media.css.
+const (
+	MediaXXSOnly   MediaQuery = "(0px <= width < 240px)"
+	MediaXXSNAbove MediaQuery = "(width >= 240px)"
+	MediaXXSNBelow MediaQuery = "(width < 240px)"
+	MediaXSOnly    MediaQuery = "(240px <= width < 360px)"
+	MediaXSNAbove  MediaQuery = "(width >= 360px)"
+	MediaXSNBelow  MediaQuery = "(width < 360px)"
+	MediaSMOnly    MediaQuery = "(360px <= width < 480px)"
+	MediaSMNAbove  MediaQuery = "(width >= 480px)"
+	MediaSMNBelow  MediaQuery = "(width < 480px)"
+	MediaMDOnly    MediaQuery = "(480px <= width < 768px)"
+	MediaMDNAbove  MediaQuery = "(width >= 768px)"
+	MediaMDNBelow  MediaQuery = "(width < 768px)"
+	MediaLGOnly    MediaQuery = "(768px <= width < 1024px)"
+	MediaLGNAbove  MediaQuery = "(width >= 1024px)"
+	MediaLGNBelow  MediaQuery = "(width < 1024px)"
+	MediaXLOnly    MediaQuery = "(1024px <= width < 1440px)"
+	MediaXLNAbove  MediaQuery = "(width >= 1440px)"
+	MediaXLNBelow  MediaQuery = "(width < 1440px)"
+	MediaXXLOnly   MediaQuery = "(1440px <= width < 1920px)"
+	MediaXXLNAbove MediaQuery = "(width >= 1920px)"
+	MediaXXLNBelow MediaQuery = "(width < 1920px)"
+
+	MediaMotionOK     MediaQuery = "(prefers-reduced-motion: no-preference)"
+	MediaMotionNotOK  MediaQuery = "(prefers-reduced-motion: reduce)"
+	MediaOpacityOK    MediaQuery = "(prefers-reduced-transparency: no-preference)"
+	MediaOpacityNotOK MediaQuery = "(prefers-reduced-transparency: reduce)"
+	MediaUseDataOK    MediaQuery = "(prefers-reduced-data: no-preference)"
+	MediaUseDataNotOK MediaQuery = "(prefers-reduced-data: reduce)"
+	MediaOSDark       MediaQuery = "(prefers-color-scheme: dark)"
+	MediaOSLight      MediaQuery = "(prefers-color-scheme: light)"
+	MediaHighContrast MediaQuery = "(prefers-contrast: more)"
+	MediaLowContrast  MediaQuery = "(prefers-contrast: less)"
+	MediaForcedColors MediaQuery = "(forced-colors: active)"
+	MediaPortrait     MediaQuery = "(orientation: portrait)"
+	MediaLandscape    MediaQuery = "(orientation: landscape)"
+	MediaHDColor      MediaQuery = "(dynamic-range: high) or (color-gamut: p3)"
+	MediaTouch        MediaQuery = "(hover: none) and (pointer: coarse)"
+	MediaStylus       MediaQuery = "(hover: none) and (pointer: fine)"
+	MediaPointer      MediaQuery = "(hover) and (pointer: coarse)"
+	MediaMouse        MediaQuery = "(hover) and (pointer: fine)"
+)
+
+// Mobile-first breakpoints: each matches its size and up, like the
+// --{size}-n-above custom media, e.g. .At(op.BreakpointMD, ...) applies at
+// widths of 768px and more. They follow Open Props, where "md" itself
+// (MediaMDOnly) is 480px to 768px, and are not aligned with src/base.css:
+// its (max-width: 768px) navigation collapse includes 768px, so at exactly
+// that width both the collapsed layout and BreakpointMD styles apply.
+const (
+	BreakpointXXS = MediaXXSNAbove
+	BreakpointXS  = MediaXSNAbove
+	BreakpointSM  = MediaSMNAbove
+	BreakpointMD  = MediaMDNAbove
+	BreakpointLG  = MediaLGNAbove
+	BreakpointXL  = MediaXLNAbove
+	BreakpointXXL = MediaXXLNAbove
+)
+
+// customMedia maps the Open Props custom media names to their queries
+var customMedia = map[string]MediaQuery{
+	"xxs-only": MediaXXSOnly, "xxs-n-above": MediaXXSNAbove, "xxs-n-below": MediaXXSNBelow,
+	"xs-only": MediaXSOnly, "xs-n-above": MediaXSNAbove, "xs-n-below": MediaXSNBelow,
+	"sm-only": MediaSMOnly, "sm-n-above": MediaSMNAbove, "sm-n-below": MediaSMNBelow,
+	"md-only": MediaMDOnly, "md-n-above": MediaMDNAbove, "md-n-below": MediaMDNBelow,
+	"lg-only": MediaLGOnly, "lg-n-above": MediaLGNAbove, "lg-n-below": MediaLGNBelow,
+	"xl-only": MediaXLOnly, "xl-n-above": MediaXLNAbove, "xl-n-below": MediaXLNBelow,
+	"xxl-only": MediaXXLOnly, "xxl-n-above": MediaXXLNAbove, "xxl-n-below": MediaXXLNBelow,
+
+	"motionOK":     MediaMotionOK,
+	"motionNotOK":  MediaMotionNotOK,
+	"opacityOK":    MediaOpacityOK,
+	"opacityNotOK": MediaOpacityNotOK,
+	"useDataOK":    MediaUseDataOK,
+	"useDataNotOK": MediaUseDataNotOK,
+	"OSdark":       MediaOSDark,
+	"OSlight":      MediaOSLight,
+	"highContrast": MediaHighContrast,
+	"lowContrast":  MediaLowContrast,
+	"forcedColors": MediaForcedColors,
+	"portrait":     MediaPortrait,
+	"landscape":    MediaLandscape,
+	"HDcolor":      MediaHDColor,
+	"touch":        MediaTouch,
+	"stylus":       MediaStylus,
+	"pointer":      MediaPointer,
+	"mouse":        MediaMouse,
+}
+
+// CustomMedia returns the query of an Open Props custom media name, with or
+// without the leading "--", e.g. CustomMedia("--lg-only")
+func CustomMedia(name string) (MediaQuery, bool) {
+	q, ok := customMedia[strings.TrimPrefix(name, "--")]
+	return q, ok
+}
+
+// At adds declarations that apply when query matches, compiled to an @media
+// rule around the style's class:
+//
+//	op.NewStyle().
+//		GridTemplateColumns("1fr").
+//		At(op.BreakpointMD, func(s *op.Style) { s.GridTemplateColumns("repeat(3, 1fr)") })
+func (s *Style) At(query MediaQuery, fn func(s *Style)) *Style {
+	return s.atRule("@media", string(query), fn)
+}
+
+// ReducedMotion adds declarations for users who prefer reduced motion
+func (s *Style) ReducedMotion(fn func(s *Style)) *Style {
+	return s.At(MediaMotionNotOK, fn)
+}
+
+// DarkScheme adds declarations for a dark operating system color scheme
+func (s *Style) DarkScheme(fn func(s *Style)) *Style {
+	return s.At(MediaOSDark, fn)
+}
+
+// LightScheme adds declarations for a light operating system color scheme
+func (s *Style) LightScheme(fn func(s *Style)) *Style {
+	return s.At(MediaOSLight, fn)
+}
+
+// AtContainer adds declarations that apply when the nearest container, or
+// the named one, matches query, compiled to an @container rule. The
+// container is set up with ContainerType or Container:
+//
+//	op.NewStyle().AtContainer("(width >= 30rem)", func(s *op.Style) { s.Display("flex") })
+//	op.NewStyle().AtContainer("sidebar (width < 20rem)", func(s *op.Style) { s.Display("none") })
+func (s *Style) AtContainer(query string, fn func(s *Style)) *Style {
+	return s.atRule("@container", query, fn)
+}
+
+// atRule adds a block wrapped in an at-rule, applying to the style itself
+func (s *Style) atRule(keyword, query string, fn func(s *Style)) *Style {
+	query = strings.Join(strings.Fields(query), " ")
+	if err := checkCondition(query); err != nil {
+		s.errs = append(s.errs, fmt.Errorf("op: %s %w", keyword, err))
+		return s
+	}
+	fn(s.block(keyword+" "+query, "&"))
+	return s
+}
+
+// checkCondition reports media and container conditions that could end the
+// rule or open a comment
+func checkCondition(query string) error {
+	if query == "" {
+		return fmt.Errorf("condition is empty")
+	}
+	if i := strings.IndexAny(query, ";{}\\@\"'"); i >= 0 {
+		return fmt.Errorf("condition %q contains %q", query, query[i])
+	}
+	if strings.Contains(query, "</") {
+		return fmt.Errorf("condition %q contains \"</\"", query)
+	}
+	if strings.Contains(query, "/*") || strings.ContainsFunc(query, func(r rune) bool { return r < ' ' || r == 0x7f }) {
+		return fmt.Errorf("condition %q contains a comment or control character", query)
+	}
+	if !balanced(query) {
+		return fmt.Errorf("condition %q has unbalanced brackets", query)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package op
+
+import (
+	"fmt"
+	"strings"
+)
+
+// block is a nested rule of a Style
+type block struct {
+	atRule   string // Enclosing at-rule, e.g. "@media (width >= 768px)", or ""
+	selector string // Relative selector, "&" stands for the parent
+	style    *Style
+}
+
+// block returns the nested style for an at-rule and selector, creating it
+// if needed
+func (s *Style) block(atRule, selector string) *Style {
+	for _, b := range s.blocks {
+		if b.atRule == atRule && b.selector == selector {
+			return b.style
+		}
+	}
+	b := &block{atRule: atRule, selector: selector, style: NewStyle()}
+	s.blocks = append(s.blocks, b)
+	return b.style
+}
+
+// Selector adds a nested block for a selector relative to the style, where &
+// stands for the style's class. Selectors without & match descendants:
+//
+//	op.NewStyle().
+//		Selector("& > svg", func(s *op.Style) { s.Width(op.Size(4)) }).
+//		Selector("&[aria-current=page]", func(s *op.Style) { s.FontWeight(op.Font.Weight(7)) })
+//
+// Calling it again with the same selector extends the existing block, and
+// blocks can be nested further. Unsafe selectors are reported by Err.
+func (s *Style) Selector(selector string, fn func(s *Style)) *Style {
+	parts := splitSelectors(strings.Join(strings.Fields(selector), " "))
+	for i, part := range parts {
+		if !strings.Contains(part, "&") {
+			parts[i] = "& " + part
+		}
+	}
+	selector = strings.Join(parts, ", ")
+	if err := checkSelector(selector); err != nil {
+		s.errs = append(s.errs, err)
+		return s
+	}
+	fn(s.block("", selector))
+	return s
+}
+
+// Hover adds declarations for &:hover
+func (s *Style) Hover(fn func(s *Style)) *Style {
+	return s.Selector("&:hover", fn)
+}
+
+// Focus adds declarations for &:focus
+func (s *Style) Focus(fn func(s *Style)) *Style {
+	return s.Selector("&:focus", fn)
+}
+
+// FocusVisible adds declarations for &:focus-visible, the keyboard focus ring
+func (s *Style) FocusVisible(fn func(s *Style)) *Style {
+	return s.Selector("&:focus-visible", fn)
+}
+
+// Active adds declarations for &:active
+func (s *Style) Active(fn func(s *Style)) *Style {
+	return s.Selector("&:active", fn)
+}
+
+// Disabled adds declarations for &:disabled
+func (s *Style) Disabled(fn func(s *Style)) *Style {
+	return s.Selector("&:disabled", fn)
+}
+
+// Before adds declarations for the &::before pseudo-element
+func (s *Style) Before(fn func(s *Style)) *Style {
+	return s.Selector("&::before", fn)
+}
+
+// After adds declarations for the &::after pseudo-element
+func (s *Style) After(fn func(s *Style)) *Style {
+	return s.Selector("&::after", fn)
+}
+
+// rules returns the CSS rules of the style for selector: its own
+// declarations first, then its nested blocks in the order they were added
+func (s *Style) rules(selector string) []string {
+	var rules []string
+	if body := s.ruleBody(); body != "" {
+		rules = append(rules, selector+"{"+body+"}")
+	}
+	for _, b := range s.blocks {
+		nested := b.style.rules(nestSelector(selector, b.selector))
+		if b.atRule != "" && len(nested) > 0 {
+			nested = []string{b.atRule + "{" + strings.Join(nested, "") + "}"}
+		}
+		rules = append(rules, nested...)
+	}
+	return rules
+}
+
+// nestSelector replaces & in child with parent, expanding selector lists:
+// nestSelector(".a, .b", "&:hover") is ".a:hover, .b:hover"
+func nestSelector(parent, child string) string {
+	var selectors []string
+	for _, c := range splitSelectors(child) {
+		for _, p := range splitSelectors(parent) {
+			selectors = append(selectors, strings.ReplaceAll(c, "&", p))
+		}
+	}
+	return strings.Join(selectors, ", ")
+}
+
+// splitSelectors splits a selector list at its top-level commas, leaving
+// the arguments of :is(), :not(), ... intact
+func splitSelectors(list string) []string {
+	var selectors []string
+	depth, start := 0, 0
+	for i, r := range list {
+		switch r {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				selectors = append(selectors, strings.TrimSpace(list[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(selectors, strings.TrimSpace(list[start:]))
+}
+
+// checkSelector reports selectors that could end the rule or open a comment
+func checkSelector(selector string) error {
+	for _, r := range selector {
+		if r < ' ' || r == 0x7f {
+			return fmt.Errorf("op: selector %q contains a control character", selector)
+		}
+	}
+	if i := strings.IndexAny(selector, ";{}<\\@"); i >= 0 {
+		return fmt.Errorf("op: selector %q contains %q", selector, selector[i])
+	}
+	if strings.Contains(selector, "/*") || strings.Contains(selector, "*/") {
+		return fmt.Errorf("op: selector %q contains a comment", selector)
+	}
+	if !balanced(selector) {
+		return fmt.Errorf("op: selector %q has unbalanced quotes or brackets", selector)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package op
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// srgb is a color in gamma-encoded sRGB with channels in [0, 1]
+type srgb struct {
+	r, g, b float64
+}
+
+// oklch is a color in the OKLCH space: lightness [0, 1], chroma, hue in degrees
+type oklch struct {
+	l, c, h float64
+}
+
+// parseColor parses a hex (#rgb, #rrggbb), rgb()/rgba() or oklch() color,
+// ignoring any alpha
+func parseColor(s string) (srgb, error) {
+	c, _, err := parseColorAlpha(s)
+	return c, err
+}
+
+// parseColorAlpha parses a hex (#rgb, #rgba, #rrggbb, #rrggbbaa),
+// rgb()/rgba(), hsl()/hsla() or oklch() color and its alpha
+func parseColorAlpha(s string) (srgb, float64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch s {
+	case "transparent":
+		return srgb{}, 0, nil
+	case "black":
+		return srgb{0, 0, 0}, 1, nil
+	case "white":
+		return srgb{1, 1, 1}, 1, nil
+	}
+	if strings.HasPrefix(s, "#") {
+		return parseHex(s)
+	}
+
+	kind, _, _ := strings.Cut(s, "(")
+	args, err := colorArgs(s)
+	if err != nil || len(args) < 3 || len(args) > 4 {
+		return srgb{}, 0, fmt.Errorf("op: invalid %s color %q", kind, s)
+	}
+	alpha := 1.0
+	if len(args) == 4 {
+		if alpha, err = parseNumber(args[3], 1); err != nil {
+			return srgb{}, 0, fmt.Errorf("op: invalid %s color %q", kind, s)
+		}
+		alpha = math.Max(0, math.Min(1, alpha))
+	}
+
+	switch kind {
+	case "rgb", "rgba":
+		var ch [3]float64
+		for i := range ch {
+			v, err := parseNumber(args[i], 255)
+			if err != nil {
+				return srgb{}, 0, fmt.Errorf("op: invalid rgb color %q", s)
+			}
+			ch[i] = v / 255
+		}
+		return srgb{ch[0], ch[1], ch[2]}, alpha, nil
+	case "hsl", "hsla":
+		h, err1 := parseNumber(strings.TrimSuffix(args[0], "deg"), 360)
+		sat, err2 := parseNumber(args[1], 100)
+		l, err3 := parseNumber(args[2], 100)
+		if err1 != nil || err2 != nil || err3 != nil {
+			return srgb{}, 0, fmt.Errorf("op: invalid hsl color %q", s)
+		}
+		return hslToSRGB(h, sat/100, l/100), alpha, nil
+	case "oklch":
+		l, err1 := parseNumber(args[0], 1)
+		c, err2 := parseNumber(args[1], 0.4)
+		h, err3 := parseNumber(strings.TrimSuffix(args[2], "deg"), 360)
+		if err1 != nil || err2 != nil || err3 != nil {
+			return srgb{}, 0, fmt.Errorf("op: invalid oklch color %q", s)
+		}
+		return oklch{l, c, h}.toSRGB(), alpha, nil
+	}
+	return srgb{}, 0, fmt.Errorf("op: unsupported color %q", s)
+}
+
+// parseHex parses #rgb, #rgba, #rrggbb and #rrggbbaa colors
+func parseHex(s string) (srgb, float64, error) {
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) == 3 || len(hex) == 4 {
+		long := make([]byte, 0, 8)
+		for i := 0; i < len(hex); i++ {
+			long = append(long, hex[i], hex[i])
+		}
+		hex = string(long)
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	if len(hex) != 8 {
+		return srgb{}, 0, fmt.Errorf("op: invalid hex color %q", s)
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return srgb{}, 0, fmt.Errorf("op: invalid hex color %q", s)
+	}
+	return srgb{
+		r: float64(v>>24&0xff) / 255,
+		g: float64(v>>16&0xff) / 255,
+		b: float64(v>>8&0xff) / 255,
+	}, float64(v&0xff) / 255, nil
+}
+
+// colorArgs splits the arguments of a color function, accepting both the
+// comma and the space separated syntax. An alpha after "/" is returned last.
+func colorArgs(s string) ([]string, error) {
+	open := strings.Index(s, "(")
+	if open < 0 || !strings.HasSuffix(s, ")") {
+		return nil, fmt.Errorf("op: invalid color function %q", s)
+	}
+	inner := strings.NewReplacer(",", " ", "/", " ").Replace(s[open+1 : len(s)-1])
+	return strings.Fields(inner), nil
+}
+
+// parseNumber parses a number or percentage, where 100% maps to percentScale
+func parseNumber(s string, percentScale float64) (float64, error) {
+	if p, ok := strings.CutSuffix(s, "%"); ok {
+		v, err := strconv.ParseFloat(p, 64)
+		return v / 100 * percentScale, err
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// toLinear converts a gamma-encoded sRGB channel to linear light
+func toLinear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// fromLinear converts a linear-light channel to gamma-encoded sRGB
+func fromLinear(c float64) float64 {
+	if c <= 0.0031308 {
+		return c * 12.92
+	}
+	return 1.055*math.Pow(c, 1/2.4) - 0.055
+}
+
+// toOKLCH converts an sRGB color to OKLCH
+func (c srgb) toOKLCH() oklch {
+	r, g, b := toLinear(c.r), toLinear(c.g), toLinear(c.b)
+
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	L := 0.2104542553*l + 0.7936177850*m - 0.0040720468*s
+	A := 1.9779984951*l - 2.4285922050*m + 0.4505937099*s
+	B := 0.0259040371*l + 0.7827717662*m - 0.8086757660*s
+
+	h := math.Atan2(B, A) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return oklch{l: L, c: math.Hypot(A, B), h: h}
+}
+
+// toSRGB converts an OKLCH color to sRGB without gamut mapping
+func (c oklch) toSRGB() srgb {
+	hr := c.h * math.Pi / 180
+	A, B := c.c*math.Cos(hr), c.c*math.Sin(hr)
+
+	l := c.l + 0.3963377774*A + 0.2158037573*B
+	m := c.l - 0.1055613458*A - 0.0638541728*B
+	s := c.l - 0.0894841775*A - 1.2914855480*B
+	l, m, s = l*l*l, m*m*m, s*s*s
+
+	return srgb{
+		r: fromLinear(4.0767416621*l - 3.3077115913*m + 0.2309699292*s),
+		g: fromLinear(-1.2684380046*l + 2.6097574011*m - 0.3413193965*s),
+		b: fromLinear(-0.0041960863*l - 0.7034186147*m + 1.7076147010*s),
+	}
+}
+
+// inGamut reports whether all channels are within [0, 1]
+func (c srgb) inGamut() bool {
+	const eps = 1e-6
+	return c.r >= -eps && c.r <= 1+eps &&
+		c.g >= -eps && c.g <= 1+eps &&
+		c.b >= -eps && c.b <= 1+eps
+}
+
+// toGamut reduces chroma, keeping lightness and hue, until the color fits sRGB
+func (c oklch) toGamut() srgb {
+	if rgb := c.toSRGB(); rgb.inGamut() {
+		return rgb
+	}
+	lo, hi := 0.0, c.c
+	for i := 0; i < 24; i++ {
+		mid := (lo + hi) / 2
+		if (oklch{c.l, mid, c.h}).toSRGB().inGamut() {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return oklch{c.l, lo, c.h}.toSRGB()
+}
+
+// hslToSRGB converts hue in degrees and saturation and lightness in [0, 1]
+func hslToSRGB(h, s, l float64) srgb {
+	h = math.Mod(math.Mod(h, 360)+360, 360)
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)
+		return l - s*math.Min(l, 1-l)*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))
+	}
+	return srgb{f(0), f(8), f(4)}
+}
+
+// toHSL converts an sRGB color to hue in degrees and saturation and
+// lightness in [0, 1]
+func (c srgb) toHSL() (h, s, l float64) {
+	max := math.Max(c.r, math.Max(c.g, c.b))
+	min := math.Min(c.r, math.Min(c.g, c.b))
+	l = (max + min) / 2
+	d := max - min
+	if d == 0 {
+		return 0, 0, l
+	}
+	s = d / (1 - math.Abs(2*l-1))
+	switch max {
+	case c.r:
+		h = math.Mod((c.g-c.b)/d+6, 6)
+	case c.g:
+		h = (c.b-c.r)/d + 2
+	default:
+		h = (c.r-c.g)/d + 4
+	}
+	return h * 60, s, l
+}
+
+// hex formats the color as #rrggbb, clamping channels to [0, 1]
+func (c srgb) hex() string {
+	channel := func(v float64) int {
+		return int(math.Round(math.Max(0, math.Min(1, v)) * 255))
+	}
+	return fmt.Sprintf("#%02x%02x%02x", channel(c.r), channel(c.g), channel(c.b))
+}
@@ -3,8 +3,11 @@
 package op
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/a-h/templ"
 )
 
 // cssVar returns a CSS variable reference string
@@ -25,99 +28,99 @@ func clamp(val, min, max int) int {
 
 // Size returns a size variable (--size-{n})
 // Valid range: 1-15 (also supports negative values 000, 00)
-func Size(n int) string {
+func Size(n int) Length {
 	switch n {
 	case -2:
-		return cssVar("size-000")
+		return Length(cssVar("size-000"))
 	case -1:
-		return cssVar("size-00")
+		return Length(cssVar("size-00"))
 	case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15:
-		return cssVar(fmt.Sprintf("size-%d", n))
+		return Length(cssVar(fmt.Sprintf("size-%d", n)))
 	default:
 		// Clamp to valid range
 		clamped := clamp(n, 1, 15)
-		return cssVar(fmt.Sprintf("size-%d", clamped))
+		return Length(cssVar(fmt.Sprintf("size-%d", clamped)))
 	}
 }
 
 // SizePx returns a pixel-based size variable (--size-px-{n})
-func SizePx(n int) string {
+func SizePx(n int) Length {
 	switch n {
 	case -2:
-		return cssVar("size-px-000")
+		return Length(cssVar("size-px-000"))
 	case -1:
-		return cssVar("size-px-00")
+		return Length(cssVar("size-px-00"))
 	case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15:
-		return cssVar(fmt.Sprintf("size-px-%d", n))
+		return Length(cssVar(fmt.Sprintf("size-px-%d", n)))
 	default:
 		clamped := clamp(n, 1, 15)
-		return cssVar(fmt.Sprintf("size-px-%d", clamped))
+		return Length(cssVar(fmt.Sprintf("size-px-%d", clamped)))
 	}
 }
 
 // SizeFluid returns a fluid size variable (--size-fluid-{n})
-func SizeFluid(n int) string {
+func SizeFluid(n int) Length {
 	if n < 1 || n > 10 {
 		n = clamp(n, 1, 10)
 	}
-	return cssVar(fmt.Sprintf("size-fluid-%d", n))
+	return Length(cssVar(fmt.Sprintf("size-fluid-%d", n)))
 }
 
 // SizeContent returns a content size variable (--size-content-{n})
-func SizeContent(n int) string {
+func SizeContent(n int) Length {
 	if n < 1 || n > 3 {
 		n = clamp(n, 1, 3)
 	}
-	return cssVar(fmt.Sprintf("size-content-%d", n))
+	return Length(cssVar(fmt.Sprintf("size-content-%d", n)))
 }
 
 // SizeHeader returns a header size variable (--size-header-{n})
-func SizeHeader(n int) string {
+func SizeHeader(n int) Length {
 	if n < 1 || n > 3 {
 		n = clamp(n, 1, 3)
 	}
-	return cssVar(fmt.Sprintf("size-header-%d", n))
+	return Length(cssVar(fmt.Sprintf("size-header-%d", n)))
 }
 
 // Shadow returns a shadow variable (--shadow-{n})
-func Shadow(n int) string {
+func Shadow(n int) ShadowValue {
 	if n < 1 || n > 6 {
 		n = clamp(n, 1, 6)
 	}
-	return cssVar(fmt.Sprintf("shadow-%d", n))
+	return ShadowValue(cssVar(fmt.Sprintf("shadow-%d", n)))
 }
 
 // InnerShadow returns an inner shadow variable (--inner-shadow-{n})
-func InnerShadow(n int) string {
+func InnerShadow(n int) ShadowValue {
 	if n < 0 || n > 4 {
 		n = clamp(n, 0, 4)
 	}
-	return cssVar(fmt.Sprintf("inner-shadow-%d", n))
+	return ShadowValue(cssVar(fmt.Sprintf("inner-shadow-%d", n)))
 }
 
 // Gradient returns a gradient variable (--gradient-{n})
-func Gradient(n int) string {
+func Gradient(n int) GradientValue {
 	if n < 1 || n > 30 {
 		n = clamp(n, 1, 30)
 	}
-	return cssVar(fmt.Sprintf("gradient-%d", n))
+	return GradientValue(cssVar(fmt.Sprintf("gradient-%d", n)))
 }
 
 // Layer returns a layer/z-index variable (--layer-{n})
-func Layer(n int) string {
+func Layer(n int) Number {
 	if n < 1 || n > 5 {
 		n = clamp(n, 1, 5)
 	}
-	return cssVar(fmt.Sprintf("layer-%d", n))
+	return Number(cssVar(fmt.Sprintf("layer-%d", n)))
 }
 
 // LayerImportant returns the important layer variable
-func LayerImportant() string {
-	return cssVar("layer-important")
+func LayerImportant() Number {
+	return Number(cssVar("layer-important"))
 }
 
 // Ratio returns an aspect ratio variable
-func Ratio(name string) string {
+func Ratio(name string) Number {
 	validRatios := map[string]bool{
 		"square":     true,
 		"landscape":  true,
@@ -128,158 +131,177 @@ func Ratio(name string) string {
 	}
 	
 	if !validRatios[name] {
-		return cssVar("ratio-square") // default
+		return Number(cssVar("ratio-square")) // default
 	}
-	return cssVar(fmt.Sprintf("ratio-%s", name))
+	return Number(cssVar(fmt.Sprintf("ratio-%s", name)))
+}
+
+//go:generate go run ../cmd/generate-style -out style_gen.go
+
+// Style provides a builder pattern for creating CSS styles. The property
+// methods are generated from cmd/generate-style/properties.txt.
+//
+// Each property is stored once with last-wins semantics: setting a property
+// again replaces its value and moves it to the end, so String() always
+// matches the order of the last calls.
+//
+// Declarations are validated as they are added. An unknown property or a
+// value that could inject declarations or script (";", "}", comments,
+// javascript: URLs, ...) is dropped and reported by Err, so String only ever
+// contains safe declarations.
+//
+// Nested blocks (Hover, Selector, At, AtContainer, ...) only take effect when the
+// style is compiled to a class with Class; String renders the element's own
+// declarations.
+type Style struct {
+	props  []declaration
+	blocks []*block
+	errs   []error
 }
 
-// Style provides a builder pattern for creating CSS styles
-type Style struct {
-	props []string
+// declaration is a single property: value pair of a Style
+type declaration struct {
+	property string
+	value    string
 }
 
 // NewStyle creates a new style builder
 func NewStyle() *Style {
 	return &Style{
-		props: make([]string, 0),
+		props: make([]declaration, 0),
 	}
 }
 
-// Background adds a background property
-func (s *Style) Background(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("background: %s", value))
-	return s
-}
-
-// Color adds a color property
-func (s *Style) Color(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("color: %s", value))
-	return s
-}
-
-// Padding adds a padding property
-func (s *Style) Padding(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("padding: %s", value))
-	return s
-}
-
-// PaddingTop adds a padding-top property
-func (s *Style) PaddingTop(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("padding-top: %s", value))
-	return s
-}
-
-// PaddingBottom adds a padding-bottom property
-func (s *Style) PaddingBottom(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("padding-bottom: %s", value))
-	return s
-}
-
-// PaddingLeft adds a padding-left property
-func (s *Style) PaddingLeft(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("padding-left: %s", value))
-	return s
-}
-
-// PaddingRight adds a padding-right property
-func (s *Style) PaddingRight(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("padding-right: %s", value))
-	return s
-}
-
-// Margin adds a margin property
-func (s *Style) Margin(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("margin: %s", value))
-	return s
-}
-
-// MarginTop adds a margin-top property
-func (s *Style) MarginTop(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("margin-top: %s", value))
-	return s
-}
-
-// MarginBottom adds a margin-bottom property
-func (s *Style) MarginBottom(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("margin-bottom: %s", value))
-	return s
-}
-
-// MarginLeft adds a margin-left property
-func (s *Style) MarginLeft(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("margin-left: %s", value))
-	return s
-}
-
-// MarginRight adds a margin-right property
-func (s *Style) MarginRight(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("margin-right: %s", value))
-	return s
+// propertyKey normalizes a property name; custom properties keep their case
+func propertyKey(property string) string {
+	property = strings.TrimSpace(property)
+	if strings.HasPrefix(property, "--") {
+		return property
+	}
+	return strings.ToLower(property)
 }
 
-// BorderRadius adds a border-radius property
-func (s *Style) BorderRadius(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("border-radius: %s", value))
-	return s
+// index returns the position of property in s.props, or -1
+func (s *Style) index(property string) int {
+	for i, d := range s.props {
+		if d.property == property {
+			return i
+		}
+	}
+	return -1
 }
 
-// BoxShadow adds a box-shadow property
-func (s *Style) BoxShadow(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("box-shadow: %s", value))
+// add sets a property declaration, replacing an earlier value. Invalid
+// declarations are recorded in s.errs instead.
+func (s *Style) add(property, value string) *Style {
+	property = propertyKey(property)
+	value = strings.TrimSpace(value)
+	if err := checkDeclaration(property, value); err != nil {
+		s.errs = append(s.errs, err)
+		return s
+	}
+	if i := s.index(property); i >= 0 {
+		s.props = append(s.props[:i], s.props[i+1:]...)
+	}
+	s.props = append(s.props, declaration{property: property, value: value})
 	return s
 }
 
-// Animation adds an animation property
-func (s *Style) Animation(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("animation: %s", value))
-	return s
+// Custom adds a CSS property the typed methods do not cover, or a raw value
+// for one they do. The property must be listed in properties.txt, a custom
+// property (--name) or vendor-prefixed (-webkit-...); the value must be safe.
+// Rejected declarations are reported by Err:
+//
+//	style := op.NewStyle().Custom("color", userColor)
+//	if err := style.Err(); err != nil {
+//		return err
+//	}
+func (s *Style) Custom(property, value string) *Style {
+	return s.add(property, value)
 }
 
-// FontSize adds a font-size property
-func (s *Style) FontSize(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("font-size: %s", value))
-	return s
+// Get returns the value of a property and whether it is set
+func (s *Style) Get(property string) (string, bool) {
+	if i := s.index(propertyKey(property)); i >= 0 {
+		return s.props[i].value, true
+	}
+	return "", false
 }
 
-// FontWeight adds a font-weight property
-func (s *Style) FontWeight(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("font-weight: %s", value))
+// Remove deletes a property
+func (s *Style) Remove(property string) *Style {
+	if i := s.index(propertyKey(property)); i >= 0 {
+		s.props = append(s.props[:i], s.props[i+1:]...)
+	}
 	return s
 }
 
-// TransitionProperty adds a transition-property property
-func (s *Style) TransitionProperty(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("transition-property: %s", value))
+// Merge applies the declarations of other on top of s, in order, so that
+// properties set in other win. Use it to combine a base style with a variant:
+//
+//	base := op.NewStyle().Padding(op.Size(3)).Color(op.Color.Text())
+//	primary := base.Clone().Merge(op.NewStyle().Color("white"))
+func (s *Style) Merge(other *Style) *Style {
+	if other == nil {
+		return s
+	}
+	for _, d := range other.props {
+		s.add(d.property, d.value)
+	}
+	for _, b := range other.blocks {
+		s.block(b.atRule, b.selector).Merge(b.style)
+	}
+	s.errs = append(s.errs, other.errs...)
 	return s
 }
 
-// TransitionDuration adds a transition-duration property
-func (s *Style) TransitionDuration(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("transition-duration: %s", value))
-	return s
+// Clone returns an independent copy of the style
+func (s *Style) Clone() *Style {
+	clone := &Style{
+		props: append(make([]declaration, 0, len(s.props)), s.props...),
+		errs:  append([]error(nil), s.errs...),
+	}
+	for _, b := range s.blocks {
+		clone.blocks = append(clone.blocks, &block{atRule: b.atRule, selector: b.selector, style: b.style.Clone()})
+	}
+	return clone
 }
 
-// TransitionTimingFunction adds a transition-timing-function property
-func (s *Style) TransitionTimingFunction(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("transition-timing-function: %s", value))
-	return s
+// Err returns the declarations and selectors rejected so far, including
+// those of nested blocks, joined, or nil
+func (s *Style) Err() error {
+	errs := append([]error(nil), s.errs...)
+	for _, b := range s.blocks {
+		errs = append(errs, b.style.Err())
+	}
+	return errors.Join(errs...)
 }
 
-// Transition adds a transition shorthand property
-func (s *Style) Transition(value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("transition: %s", value))
-	return s
+// String returns the CSS string
+func (s *Style) String() string {
+	parts := make([]string, len(s.props))
+	for i, d := range s.props {
+		parts[i] = d.property + ": " + d.value
+	}
+	return strings.Join(parts, "; ")
 }
 
-// Custom adds a custom CSS property
-func (s *Style) Custom(property, value string) *Style {
-	s.props = append(s.props, fmt.Sprintf("%s: %s", property, value))
-	return s
+// ruleBody returns the declarations in the compact form used inside a class rule
+func (s *Style) ruleBody() string {
+	parts := make([]string, len(s.props))
+	for i, d := range s.props {
+		parts[i] = d.property + ":" + d.value
+	}
+	return strings.Join(parts, ";")
 }
 
-// String returns the CSS string
-func (s *Style) String() string {
-	return strings.Join(s.props, "; ")
+// SafeString returns the CSS string as templ.SafeCSS. Every declaration was
+// validated when it was added, so templ renders the style attribute as is
+// instead of escaping quoted font names or replacing values it cannot verify:
+//
+//	<div style={ op.NewStyle().Color(userColor).SafeString() }>
+func (s *Style) SafeString() templ.SafeCSS {
+	return templ.SafeCSS(s.String())
 }
 
 // Common preset sizes
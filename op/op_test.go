@@ -1,8 +1,19 @@
 package op
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/riclib/open-props-css/uicss"
 )
 
 func TestCssVar(t *testing.T) {
@@ -16,7 +27,7 @@ func TestCssVar(t *testing.T) {
 func TestSize(t *testing.T) {
 	tests := []struct {
 		input    int
-		expected string
+		expected Length
 	}{
 		{-2, "var(--size-000)"},
 		{-1, "var(--size-00)"},
@@ -37,7 +48,7 @@ func TestSize(t *testing.T) {
 func TestColorGray(t *testing.T) {
 	tests := []struct {
 		scale    int
-		expected string
+		expected ColorValue
 	}{
 		{0, "var(--gray-0)"},
 		{6, "var(--gray-6)"},
@@ -56,7 +67,7 @@ func TestColorGray(t *testing.T) {
 func TestFontSize(t *testing.T) {
 	tests := []struct {
 		size     int
-		expected string
+		expected Length
 	}{
 		{0, "var(--font-size-0)"},
 		{3, "var(--font-size-3)"},
@@ -75,8 +86,8 @@ func TestFontSize(t *testing.T) {
 func TestAnimation(t *testing.T) {
 	tests := []struct {
 		name     string
-		fn       func() string
-		expected string
+		fn       func() AnimationValue
+		expected AnimationValue
 	}{
 		{"FadeIn", Animation.FadeIn, "var(--animation-fade-in)"},
 		{"Bounce", Animation.Bounce, "var(--animation-bounce)"},
@@ -95,7 +106,7 @@ func TestAnimation(t *testing.T) {
 func TestGradient(t *testing.T) {
 	tests := []struct {
 		n        int
-		expected string
+		expected GradientValue
 	}{
 		{1, "var(--gradient-1)"},
 		{15, "var(--gradient-15)"},
@@ -146,12 +157,16 @@ func TestStyleBuilder(t *testing.T) {
 	if !strings.Contains(result, "; ") {
 		t.Error("Style.String() should separate properties with '; '")
 	}
+
+	if got := NewStyle().Background(Bg("transparent")).String(); got != "background: transparent" {
+		t.Errorf("Background(Bg()) = %q", got)
+	}
 }
 
 func TestBorderSize(t *testing.T) {
 	tests := []struct {
 		size     int
-		expected string
+		expected Length
 	}{
 		{1, "var(--border-size-1)"},
 		{3, "var(--border-size-3)"},
@@ -170,7 +185,7 @@ func TestBorderSize(t *testing.T) {
 func TestRadius(t *testing.T) {
 	// Test regular radius
 	result := Radius(3)
-	expected := "var(--radius-3)"
+	expected := Length("var(--radius-3)")
 	if result != expected {
 		t.Errorf("Radius(3) = %v, want %v", result, expected)
 	}
@@ -193,13 +208,13 @@ func TestRadius(t *testing.T) {
 func TestEasing(t *testing.T) {
 	tests := []struct {
 		name     string
-		fn       func() string
-		expected string
+		fn       func() Easing
+		expected Easing
 	}{
-		{"Default", func() string { return Ease.Default(3) }, "var(--ease-3)"},
-		{"In", func() string { return Ease.In(2) }, "var(--ease-in-2)"},
-		{"Out", func() string { return Ease.Out(4) }, "var(--ease-out-4)"},
-		{"Elastic", func() string { return Ease.Elastic(1) }, "var(--ease-elastic-1)"},
+		{"Default", func() Easing { return Ease.Default(3) }, "var(--ease-3)"},
+		{"In", func() Easing { return Ease.In(2) }, "var(--ease-in-2)"},
+		{"Out", func() Easing { return Ease.Out(4) }, "var(--ease-out-4)"},
+		{"Elastic", func() Easing { return Ease.Elastic(1) }, "var(--ease-elastic-1)"},
 		{"CircIn", Ease.CircIn, "var(--ease-circ-in)"},
 		{"CubicInOut", Ease.CubicInOut, "var(--ease-cubic-in-out)"},
 	}
@@ -215,8 +230,8 @@ func TestEasing(t *testing.T) {
 func TestThemeColors(t *testing.T) {
 	tests := []struct {
 		name     string
-		fn       func() string
-		expected string
+		fn       func() ColorValue
+		expected ColorValue
 	}{
 		{"Background", Color.Background, "var(--background)"},
 		{"Surface", Color.Surface, "var(--surface)"},
@@ -231,4 +246,658 @@ func TestThemeColors(t *testing.T) {
 			t.Errorf("Color.%s() = %v, want %v", tt.name, result, tt.expected)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestTypedValues(t *testing.T) {
+	tests := []struct {
+		value    fmt.Stringer
+		expected string
+	}{
+		{Size(4), "var(--size-4)"},
+		{Color.Red(5), "var(--red-5)"},
+		{Px(12), "12px"},
+		{Rem(1.5), "1.5rem"},
+		{Percent(50), "50%"},
+		{Ms(200), "200ms"},
+	}
+
+	for _, tt := range tests {
+		if result := tt.value.String(); result != tt.expected {
+			t.Errorf("%T.String() = %q, want %q", tt.value, result, tt.expected)
+		}
+	}
+
+	style := NewStyle().
+		Padding("0 auto").
+		Margin(Px(8)).
+		TransitionDuration(Ms(150)).
+		TransitionTimingFunction(Ease.Out(3)).
+		Custom("grid-template-columns", "1fr 2fr").
+		String()
+	expected := "padding: 0 auto; margin: 8px; transition-duration: 150ms; transition-timing-function: var(--ease-out-3); grid-template-columns: 1fr 2fr"
+	if style != expected {
+		t.Errorf("Style.String() = %q, want %q", style, expected)
+	}
+}
+
+func TestNewPalette(t *testing.T) {
+	inputs := []string{"#228be6", "#0066cc", "rgb(124, 58, 237)", "oklch(70% 0.15 30)"}
+
+	for _, input := range inputs {
+		p, err := NewPalette("brand", input)
+		if err != nil {
+			t.Fatalf("NewPalette(%q) error = %v", input, err)
+		}
+
+		// The brand color is kept exactly at its step
+		brand, _ := parseColor(input)
+		if p.Scale[p.BrandStep] != brand.hex() {
+			t.Errorf("NewPalette(%q).Scale[%d] = %v, want %v", input, p.BrandStep, p.Scale[p.BrandStep], brand.hex())
+		}
+
+		// Lightness decreases from 0 to 12
+		for i := 1; i < len(p.Scale); i++ {
+			prev, _ := parseColor(p.Scale[i-1])
+			curr, _ := parseColor(p.Scale[i])
+			if curr.toOKLCH().l >= prev.toOKLCH().l {
+				t.Errorf("NewPalette(%q): step %d (%s) is not darker than step %d (%s)", input, i, p.Scale[i], i-1, p.Scale[i-1])
+			}
+		}
+	}
+
+	p, _ := NewPalette("brand", "#228be6")
+	if result := p.Color(5); result != "var(--brand-5)" {
+		t.Errorf("Palette.Color(5) = %v, want var(--brand-5)", result)
+	}
+	css := p.CSS()
+	for _, part := range []string{"--brand-0: ", "--brand-12: ", "--primary: light-dark(", ".light {", ".dark {"} {
+		if !strings.Contains(css, part) {
+			t.Errorf("Palette.CSS() missing %v", part)
+		}
+	}
+
+	for _, bad := range []string{"blue", "#12", "rgb(1, 2)"} {
+		if _, err := NewPalette("brand", bad); err == nil {
+			t.Errorf("NewPalette(%q) should fail", bad)
+		}
+	}
+	if _, err := NewPalette("Brand Color", "#228be6"); err == nil {
+		t.Error("NewPalette() should reject invalid names")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		token    string
+		expected string
+	}{
+		{"size-4", "1.25rem"},
+		{"--size-px-15", "480px"},
+		{string(Font.Weight(6)), "600"},
+		{string(Radius(3)), "1rem"},
+		{string(Color.Blue(6)), "#228be6"},
+		{string(Ease.Squish(2)), "cubic-bezier(.5,-.3,.1,1.5)"},
+		{string(Color.Primary()), "#0066cc"},
+	}
+
+	for _, tt := range tests {
+		result, ok := Resolve(tt.token)
+		if !ok || result != tt.expected {
+			t.Errorf("Resolve(%q) = %q, %v, want %q", tt.token, result, ok, tt.expected)
+		}
+	}
+
+	if result, _ := ResolveDark(Color.Primary()); result != "#3b82f6" {
+		t.Errorf("ResolveDark(Color.Primary()) = %q, want #3b82f6", result)
+	}
+	if result, _ := ResolveDark(Size(4)); result != "1.25rem" {
+		t.Errorf("ResolveDark(Size(4)) = %q, want 1.25rem", result)
+	}
+	if _, ok := Resolve("does-not-exist"); ok {
+		t.Error("Resolve() should report unknown tokens")
+	}
+}
+
+func TestExportTokens(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportTokens(&buf, FormatDTCG); err != nil {
+		t.Fatalf("ExportTokens(FormatDTCG) error: %v", err)
+	}
+
+	var doc map[string]map[string]struct {
+		Value      any                       `json:"$value"`
+		Type       string                    `json:"$type"`
+		Extensions map[string]map[string]any `json:"$extensions"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("ExportTokens(FormatDTCG) wrote invalid JSON: %v", err)
+	}
+
+	size := doc["primitive"]["size-4"]
+	if size.Value != "1.25rem" || size.Type != "dimension" {
+		t.Errorf("size-4 = %v (%s), want 1.25rem (dimension)", size.Value, size.Type)
+	}
+	primary := doc["semantic"]["primary"]
+	if primary.Value != "#0066cc" || primary.Type != "color" {
+		t.Errorf("primary = %v (%s), want #0066cc (color)", primary.Value, primary.Type)
+	}
+	if dark := primary.Extensions[dtcgExtension]["dark"]; dark != "#3b82f6" {
+		t.Errorf("primary dark = %v, want #3b82f6", dark)
+	}
+	if padding := doc["semantic"]["container-padding"]; padding.Value != "{primitive.size-4}" || padding.Type != "dimension" {
+		t.Errorf("container-padding = %v (%s), want {primitive.size-4} (dimension)", padding.Value, padding.Type)
+	}
+	if weight := doc["primitive"]["font-weight-6"].Value; weight != 600.0 {
+		t.Errorf("font-weight-6 = %v, want 600", weight)
+	}
+
+	for _, format := range TokenFormats {
+		buf.Reset()
+		if err := ExportTokens(&buf, format); err != nil || !json.Valid(buf.Bytes()) {
+			t.Errorf("ExportTokens(%q) did not produce valid JSON: %v", format, err)
+		}
+	}
+	if err := ExportTokens(&buf, "yaml"); err == nil {
+		t.Error("ExportTokens() should reject unknown formats")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	// Every accessor must reference a variable defined in the embedded CSS,
+	// so upgrading Open Props cannot silently break them
+	for _, p := range Validate(uicss.CSS()) {
+		t.Error(p)
+	}
+
+	css := strings.Replace(uicss.CSS(), "--size-px-15:", "--size-px-x:", 1)
+	problems := Validate(css)
+	if len(problems) != 1 || problems[0].Variable != "--size-px-15" || problems[0].Accessor != "op.SizePx(15)" {
+		t.Errorf("Validate() with --size-px-15 removed = %v, want op.SizePx(15)", problems)
+	}
+}
+
+func TestCustomProperties(t *testing.T) {
+	props := CustomProperties(`:root{--a:1px;--b : var(--c)}/* --d: 1 */.x{color:var(--e);--f:}`)
+	for name, expected := range map[string]bool{"--a": true, "--b": true, "--c": false, "--d": false, "--e": false, "--f": true} {
+		if props[name] != expected {
+			t.Errorf("CustomProperties()[%q] = %v, want %v", name, props[name], expected)
+		}
+	}
+}
+
+func TestStrict(t *testing.T) {
+	if result, err := Strict.Size(4); err != nil || result != Size(4) {
+		t.Errorf("Strict.Size(4) = %q, %v, want %q", result, err, Size(4))
+	}
+	if result, err := Strict.Size(-2); err != nil || result != "var(--size-000)" {
+		t.Errorf("Strict.Size(-2) = %q, %v, want var(--size-000)", result, err)
+	}
+
+	errors := []struct {
+		call     func() error
+		expected string
+	}{
+		{func() error { _, err := Strict.Size(20); return err }, "op: Size(20) is out of range, valid range is -2, -1, 1-15"},
+		{func() error { _, err := Strict.Size(0); return err }, "op: Size(0) is out of range, valid range is -2, -1, 1-15"},
+		{func() error { _, err := Strict.Color.Blue(-3); return err }, "op: Color.Blue(-3) is out of range, valid range is 0-12"},
+		{func() error { _, err := Strict.Ease.Spring(6); return err }, "op: Ease.Spring(6) is out of range, valid range is 1-5"},
+		{func() error { _, err := Strict.Ratio("foo"); return err }, `op: Ratio("foo") is unknown, valid names are square, landscape, portrait, widescreen, ultrawide, golden`},
+	}
+	for _, tt := range errors {
+		if err := tt.call(); err == nil || err.Error() != tt.expected {
+			t.Errorf("error = %v, want %q", err, tt.expected)
+		}
+	}
+
+	// Each strict range must match the clamping of its lenient accessor: the
+	// accepted arguments produce distinct tokens and cover every token the
+	// lenient accessor can return
+	lenient := make(map[string]reflect.Value)
+	for _, f := range packageFuncs {
+		lenient[f.name] = reflect.ValueOf(f.fn)
+	}
+	for _, g := range accessorGroups {
+		v := reflect.ValueOf(g.value)
+		for i := 0; i < v.NumMethod(); i++ {
+			lenient[g.name+"."+v.Type().Method(i).Name] = v.Method(i)
+		}
+	}
+
+	strictGroups := map[string]reflect.Value{"": reflect.ValueOf(Strict)}
+	for i, f := range reflect.VisibleFields(reflect.TypeOf(*Strict)) {
+		strictGroups[f.Name+"."] = reflect.ValueOf(*Strict).Field(i)
+	}
+	for prefix, group := range strictGroups {
+		for i := 0; i < group.NumMethod(); i++ {
+			name := prefix + group.Type().Method(i).Name
+			method := group.Method(i)
+			if method.Type().NumIn() != 1 || method.Type().In(0).Kind() != reflect.Int {
+				continue
+			}
+			fn, ok := lenient[name]
+			if !ok {
+				t.Errorf("Strict.%s has no lenient accessor", name)
+				continue
+			}
+			accepted := make(map[string]bool)
+			for n := -3; n <= 31; n++ {
+				out := method.Call([]reflect.Value{reflect.ValueOf(n)})
+				if !out[1].IsNil() {
+					continue
+				}
+				if accepted[out[0].String()] {
+					t.Errorf("Strict.%s(%d) accepts a clamped argument", name, n)
+				}
+				accepted[out[0].String()] = true
+			}
+			for n := -3; n <= 31; n++ {
+				value := fn.Call([]reflect.Value{reflect.ValueOf(n)})[0].String()
+				// Font.LineHeight maps 0 to --font-lineheight-00 but clamps
+				// negative arguments to --font-lineheight-0
+				if name == "Font.LineHeight" && n < 0 {
+					continue
+				}
+				if !accepted[value] {
+					t.Errorf("Strict.%s rejects every argument for %s", name, value)
+				}
+			}
+		}
+	}
+}
+
+func TestStyleProperties(t *testing.T) {
+	result := NewStyle().
+		Display("grid").
+		GridTemplateColumns("repeat(auto-fill, minmax(20rem, 1fr))").
+		Gap(Size(3)).
+		PaddingInline(Size(4)).
+		MarginBlock(Size(2)).
+		Position("sticky").
+		InsetBlockStart(Size(1)).
+		ZIndex(Layer(2)).
+		Opacity("0.8").
+		OutlineColor(Color.Primary()).
+		ContainerType("inline-size").
+		String()
+
+	expected := "display: grid; grid-template-columns: repeat(auto-fill, minmax(20rem, 1fr)); gap: var(--size-3); " +
+		"padding-inline: var(--size-4); margin-block: var(--size-2); position: sticky; inset-block-start: var(--size-1); " +
+		"z-index: var(--layer-2); opacity: 0.8; outline-color: var(--primary); container-type: inline-size"
+	if result != expected {
+		t.Errorf("Style.String() = %q, want %q", result, expected)
+	}
+}
+
+func TestStyleOverride(t *testing.T) {
+	style := NewStyle().
+		Padding(Size(2)).
+		Color(Color.Text()).
+		Padding(Size(4))
+	if result, expected := style.String(), "color: var(--text); padding: var(--size-4)"; result != expected {
+		t.Errorf("Style.String() = %q, want %q", result, expected)
+	}
+
+	if value, ok := style.Get("padding"); !ok || value != "var(--size-4)" {
+		t.Errorf("Get(padding) = %q, %v, want var(--size-4)", value, ok)
+	}
+	if _, ok := style.Get("margin"); ok {
+		t.Error("Get(margin) should report unset properties")
+	}
+
+	base := NewStyle().Padding(Size(3)).Background(Color.Surface()).FontWeight(Font.Weight(6))
+	variant := base.Clone().Merge(NewStyle().Background(Color.Primary()).Color("white"))
+	expected := "padding: var(--size-3); font-weight: var(--font-weight-6); background: var(--primary); color: white"
+	if result := variant.String(); result != expected {
+		t.Errorf("Merge() = %q, want %q", result, expected)
+	}
+	if result := base.String(); result != "padding: var(--size-3); background: var(--surface); font-weight: var(--font-weight-6)" {
+		t.Errorf("Clone() should not share state with the original, base = %q", result)
+	}
+
+	variant.Remove("Font-Weight").Remove("missing")
+	if _, ok := variant.Get("font-weight"); ok {
+		t.Error("Remove() did not delete font-weight")
+	}
+	if result := NewStyle().Custom("--Accent", "red").Custom("--accent", "blue").String(); result != "--Accent: red; --accent: blue" {
+		t.Errorf("custom properties should be case-sensitive, got %q", result)
+	}
+}
+
+func TestStyleSafety(t *testing.T) {
+	tests := []struct {
+		property string
+		value    string
+		valid    bool
+	}{
+		{"color", "var(--blue-5)", true},
+		{"font-family", `"Segoe UI", system-ui`, true},
+		{"background-image", "url(/img/bg.png)", true},
+		{"background-image", `url("https://example.com/a.png"), var(--gradient-3)`, true},
+		{"background-image", "url(data:image/png;base64,AAAA)", false}, // ";" is never allowed
+		{"--brand", "#3b82f6", true},
+		{"-webkit-line-clamp", "3", true},
+		{"Grid-Template-Columns", "1fr 2fr", true},
+		{"colour", "red", false},
+		{"width", "", false},
+		{"width", "  ", false},
+		{"color; background", "red", false},
+		{"color", "red; background: url(x)", false},
+		{"color", "red} body{display:none", false},
+		{"color", "red\"><script>", false},
+		{"color", "red /* x */", false},
+		{"color", "red\nbackground: blue", false},
+		{"color", `\72 ed`, false},
+		{"color", "rgb(1, 2, 3", false},
+		{"content", `"unterminated`, false},
+		{"content", `"(" ")"`, true},
+		{"background-image", "url(javascript:alert(1))", false},
+		{"background-image", "URL( 'JavaScript:alert(1)' )", false},
+		{"background-image", "url(jav ascript:x)", false},
+		{"width", "expression(alert(1))", false},
+		{"width", "Expression (alert(1))", false},
+	}
+
+	for _, test := range tests {
+		style := NewStyle().Custom(test.property, test.value)
+		if valid := style.Err() == nil; valid != test.valid {
+			t.Errorf("Custom(%q, %q) valid = %v, want %v (err: %v)", test.property, test.value, valid, test.valid, style.Err())
+		}
+		if !test.valid && style.String() != "" {
+			t.Errorf("Custom(%q, %q) kept the declaration: %q", test.property, test.value, style.String())
+		}
+	}
+
+	// A rejected value keeps the earlier one and the error survives Merge and Clone
+	style := NewStyle().Color("red").Merge(NewStyle().Color("blue; x: y"))
+	if result := style.Clone().String(); result != "color: red" {
+		t.Errorf("rejected override changed the style: %q", result)
+	}
+	if err := style.Clone().Err(); err == nil || !strings.Contains(err.Error(), `op: color value "blue; x: y" contains ';'`) {
+		t.Errorf("Err() = %v, want the rejected color", err)
+	}
+	if style := NewStyle().Width(""); style.String() != "" || style.Err() == nil || style.Err().Error() != "op: width value is empty" {
+		t.Errorf(`Width("") = %q, %v, want no declaration and an error`, style.String(), style.Err())
+	}
+
+	safe := NewStyle().FontFamily(`"Segoe UI", sans-serif`).Padding(Size(3)).SafeString()
+	if safe != `font-family: "Segoe UI", sans-serif; padding: var(--size-3)` {
+		t.Errorf("SafeString() = %q", safe)
+	}
+}
+
+func TestClass(t *testing.T) {
+	sheet := NewSheet()
+	a := sheet.Class(NewStyle().Padding(Size(3)).Color(Color.Text()))
+	b := sheet.Class(NewStyle().Padding(Size(3)).Color(Color.Text()))
+	c := sheet.Class(NewStyle().Padding(Size(4)))
+	empty := sheet.Class(NewStyle())
+
+	if a.ClassName() != b.ClassName() {
+		t.Errorf("identical styles got classes %q and %q", a.ClassName(), b.ClassName())
+	}
+	if a.ClassName() == c.ClassName() {
+		t.Errorf("different styles share class %q", a.ClassName())
+	}
+	if !strings.HasPrefix(a.ClassName(), "op-") || len(a.ClassName()) != 19 {
+		t.Errorf("Class() = %q, want op- followed by 16 hex digits", a.ClassName())
+	}
+	if result := templ.Classes("btn", a).String(); result != "btn "+a.ClassName() {
+		t.Errorf("templ renders class attribute %q", result)
+	}
+	if empty.ClassName() == "" || sheet.Len() != 2 {
+		t.Errorf("Len() = %d, want 2 rules with the empty style skipped", sheet.Len())
+	}
+
+	expected := "." + a.ClassName() + "{padding:var(--size-3);color:var(--text)}\n." + c.ClassName() + "{padding:var(--size-4)}\n"
+	if css := sheet.CSS(); css != expected {
+		t.Errorf("CSS() = %q, want %q", css, expected)
+	}
+
+	var buf bytes.Buffer
+	ctx := templ.WithNonce(context.Background(), "abc")
+	if err := sheet.Style().Render(ctx, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if result := buf.String(); result != `<style nonce="abc">`+"\n"+expected+"</style>" {
+		t.Errorf("Style() rendered %q", result)
+	}
+
+	handler := sheet.Handler()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/op.css", nil))
+	if rec.Body.String() != expected || rec.Header().Get("Content-Type") != "text/css; charset=utf-8" {
+		t.Errorf("Handler() served %q as %q", rec.Body.String(), rec.Header().Get("Content-Type"))
+	}
+	req := httptest.NewRequest("GET", "/op.css", nil)
+	req.Header.Set("If-None-Match", rec.Header().Get("ETag"))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("Handler() with matching ETag = %d, want 304", rec.Code)
+	}
+
+	if SheetFrom(context.Background()) != DefaultSheet || SheetFrom(WithSheet(context.Background(), sheet)) != sheet {
+		t.Error("SheetFrom() did not return the sheet of the context")
+	}
+
+	// A hash collision must not hand out the rules of another style
+	collided := NewSheet()
+	name := c.ClassName()
+	collided.rules[name] = ".other{color:red}"
+	if class := collided.Class(NewStyle().Padding(Size(4))).ClassName(); class != name+"-2" {
+		t.Errorf("Class() on a collision = %q, want %q", class, name+"-2")
+	}
+	if class := collided.Class(NewStyle().Padding(Size(4))).ClassName(); class != name+"-2" || collided.Len() != 1 {
+		t.Errorf("Class() again = %q with %d rules, want %q with 1", class, collided.Len(), name+"-2")
+	}
+}
+
+func TestStyleNested(t *testing.T) {
+	style := NewStyle().
+		Color(Color.Text()).
+		Hover(func(s *Style) { s.Color(Color.Primary()) }).
+		FocusVisible(func(s *Style) { s.Outline("2px solid var(--primary)") }).
+		Selector("& > svg", func(s *Style) {
+			s.Width(Size(4))
+			s.Hover(func(s *Style) { s.Opacity("0.8") })
+		}).
+		Selector("[aria-current=page], .active", func(s *Style) { s.FontWeight(Font.Weight(7)) }).
+		Before(func(s *Style) { s.Content(`"→"`) }).
+		Hover(func(s *Style) { s.TextDecoration("underline") })
+
+	if result := style.String(); result != "color: var(--text)" {
+		t.Errorf("String() = %q, want only the element's declarations", result)
+	}
+
+	expected := []string{
+		".x{color:var(--text)}",
+		".x:hover{color:var(--primary);text-decoration:underline}",
+		".x:focus-visible{outline:2px solid var(--primary)}",
+		".x > svg{width:var(--size-4)}",
+		".x > svg:hover{opacity:0.8}",
+		".x [aria-current=page], .x .active{font-weight:var(--font-weight-7)}",
+		`.x::before{content:"→"}`,
+	}
+	if result := style.rules(".x"); !reflect.DeepEqual(result, expected) {
+		t.Errorf("rules() = %q, want %q", result, expected)
+	}
+	if result := nestSelector(".a, .b", "&:is(:hover, :focus) span"); result != ".a:is(:hover, :focus) span, .b:is(:hover, :focus) span" {
+		t.Errorf("nestSelector() = %q", result)
+	}
+
+	// Nested blocks take part in the class hash, Clone and Merge
+	sheet := NewSheet()
+	plain := sheet.Class(NewStyle().Color(Color.Text()))
+	if class := sheet.Class(style); class.ClassName() == plain.ClassName() {
+		t.Error("nested blocks should change the class")
+	}
+	clone := style.Clone()
+	clone.Hover(func(s *Style) { s.Color("red") })
+	if result := style.rules(".x")[1]; result != expected[1] {
+		t.Errorf("Clone() shares nested blocks, original hover = %q", result)
+	}
+	merged := NewStyle().Hover(func(s *Style) { s.Color("red").Opacity("0.5") }).Merge(style)
+	if result := merged.rules(".x")[1]; result != ".x:hover{opacity:0.5;color:var(--primary);text-decoration:underline}" {
+		t.Errorf("Merge() hover = %q", result)
+	}
+
+	for _, selector := range []string{"&} body{", "&:hover; color: red", "&[data-x", "& /* x */", "@media x"} {
+		bad := NewStyle().Selector(selector, func(s *Style) { s.Color("red") })
+		if bad.Err() == nil || len(bad.rules(".x")) != 0 {
+			t.Errorf("Selector(%q) was accepted", selector)
+		}
+	}
+	if err := NewStyle().Hover(func(s *Style) { s.Color("red;") }).Err(); err == nil {
+		t.Error("Err() should report invalid declarations in nested blocks")
+	}
+}
+
+func TestStyleMedia(t *testing.T) {
+	style := NewStyle().
+		GridTemplateColumns("1fr").
+		At(BreakpointMD, func(s *Style) {
+			s.GridTemplateColumns("repeat(3, 1fr)")
+			s.Hover(func(s *Style) { s.Color(Color.Primary()) })
+		}).
+		ReducedMotion(func(s *Style) { s.Transition("none") }).
+		DarkScheme(func(s *Style) { s.BoxShadow(Shadow(1)) }).
+		Hover(func(s *Style) {
+			s.At(MediaMouse, func(s *Style) { s.Translate("0 -1px") })
+		}).
+		AtContainer("sidebar (width < 20rem)", func(s *Style) { s.Display("none") }).
+		At(BreakpointMD, func(s *Style) { s.Gap(Size(4)) })
+
+	expected := []string{
+		".x{grid-template-columns:1fr}",
+		"@media (width >= 768px){.x{grid-template-columns:repeat(3, 1fr);gap:var(--size-4)}.x:hover{color:var(--primary)}}",
+		"@media (prefers-reduced-motion: reduce){.x{transition:none}}",
+		"@media (prefers-color-scheme: dark){.x{box-shadow:var(--shadow-1)}}",
+		"@media (hover) and (pointer: fine){.x:hover{translate:0 -1px}}",
+		"@container sidebar (width < 20rem){.x{display:none}}",
+	}
+	if result := style.rules(".x"); !reflect.DeepEqual(result, expected) {
+		t.Errorf("rules() = %q, want %q", result, expected)
+	}
+
+	if q, ok := CustomMedia("--lg-only"); !ok || q != "(768px <= width < 1024px)" {
+		t.Errorf("CustomMedia(--lg-only) = %q, %v", q, ok)
+	}
+	if _, ok := CustomMedia("md"); ok {
+		t.Error("CustomMedia(md) should be unknown")
+	}
+	if result := MediaHDColor.And(MediaLandscape); result != "((dynamic-range: high) or (color-gamut: p3)) and (orientation: landscape)" {
+		t.Errorf("And() = %q", result)
+	}
+
+	for _, query := range []string{"", "screen{", "(width > 1px)} .x{color:red", "(width: 1px", "print /* x */", "</style>"} {
+		bad := NewStyle().At(MediaQuery(query), func(s *Style) { s.Color("red") })
+		if bad.Err() == nil || len(bad.rules(".x")) != 0 {
+			t.Errorf("At(%q) was accepted", query)
+		}
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected ColorValue
+	}{
+		{"#228be6", "#228be6"},
+		{"#FFF", "#ffffff"},
+		{"#0008", "#00000088"},
+		{"#228be680", "#228be680"},
+		{"rgb(34, 139, 230)", "#228be6"},
+		{"rgb(34 139 230 / 50%)", "#228be680"},
+		{"rgba(255, 0, 0, 0.25)", "#ff000040"},
+		{"hsl(0 100% 50%)", "#ff0000"},
+		{"hsla(120deg, 100%, 25%, 1)", "#008000"},
+		{"oklch(62.8% 0.2577 29.23)", "#ff0000"},
+		{"transparent", "#00000000"},
+		{"White", "#ffffff"},
+	}
+	for _, test := range tests {
+		c, err := ParseColor(test.input)
+		if err != nil {
+			t.Errorf("ParseColor(%q) error: %v", test.input, err)
+			continue
+		}
+		if result := c.Hex(); result != test.expected {
+			t.Errorf("ParseColor(%q).Hex() = %q, want %q", test.input, result, test.expected)
+		}
+	}
+	for _, input := range []string{"", "#12345", "rgb(1, 2)", "hsl(a b c)", "var(--blue-6)", "cmyk(0 0 0 0)"} {
+		if _, err := ParseColor(input); err == nil {
+			t.Errorf("ParseColor(%q) should fail", input)
+		}
+	}
+}
+
+func TestColorMath(t *testing.T) {
+	blue, err := Color.Blue(6).RGBA()
+	if err != nil || blue.Hex() != "#228be6" {
+		t.Fatalf("Color.Blue(6).RGBA() = %v, %v", blue, err)
+	}
+	if dark, _ := Color.TextMuted().RGBADark(); dark.Hex() != "#a0a0a0" {
+		t.Errorf("Color.TextMuted().RGBADark() = %v, want #a0a0a0", dark)
+	}
+
+	formats := []struct {
+		result   ColorValue
+		expected ColorValue
+	}{
+		{blue.RGB(), "rgb(34 139 230)"},
+		{blue.WithAlpha(0.3).RGB(), "rgb(34 139 230 / 0.3)"},
+		{blue.HSL(), "hsl(207.9 79.7% 51.8%)"},
+		{RGBA{1, 1, 1, 1}.OKLCH(), "oklch(1 0 0)"},
+		{HSL(0, 1, 0.5).Hex(), "#ff0000"},
+		{RGBA{0, 0, 0, 1}.Mix(RGBA{1, 1, 1, 1}, 0).Hex(), "#000000"},
+		{RGBA{1, 0, 0, 1}.Mix(RGBA{0, 0, 1, 1}, 1).Hex(), "#0000ff"},
+		{RGBA{1, 0, 0, 1}.Mix(RGBA{0, 0, 0, 0}, 0.5).Hex(), "#ff000080"},
+		{OKLCH(2, 1, 30).Hex(), "#ffffff"},
+	}
+	for i, f := range formats {
+		if f.result != f.expected {
+			t.Errorf("format %d = %q, want %q", i, f.result, f.expected)
+		}
+	}
+
+	// Lightness and chroma adjustments move in the right direction and keep alpha
+	l, c, _ := blue.ToOKLCH()
+	lighter := blue.WithAlpha(0.5).Lighten(0.1)
+	if l2, _, _ := lighter.ToOKLCH(); math.Abs(l2-(l+0.1)) > 0.01 || lighter.A != 0.5 {
+		t.Errorf("Lighten(0.1) lightness = %.3f (alpha %v), want %.3f", l2, lighter.A, l+0.1)
+	}
+	if l2, _, _ := blue.Darken(0.2).ToOKLCH(); math.Abs(l2-(l-0.2)) > 0.01 {
+		t.Errorf("Darken(0.2) lightness = %.3f, want %.3f", l2, l-0.2)
+	}
+	if _, c2, _ := blue.Desaturate(1).ToOKLCH(); c2 > 0.001 {
+		t.Errorf("Desaturate(1) chroma = %.3f, want gray", c2)
+	}
+	if _, c2, _ := blue.Desaturate(0.05).ToOKLCH(); math.Abs(c2-(c-0.05)) > 0.01 {
+		t.Errorf("Desaturate(0.05) chroma = %.3f, want %.3f", c2, c-0.05)
+	}
+	black, white := RGBA{0, 0, 0, 1}, RGBA{1, 1, 1, 1}
+	if gray := black.Mix(white, 0.5); gray.Hex() != "#636363" {
+		t.Errorf("Mix(black, white) = %v, want the OKLab midpoint #636363", gray)
+	}
+
+	expressions := []struct {
+		result   ColorValue
+		expected ColorValue
+	}{
+		{Color.Blue(6).Alpha(0.3), "color-mix(in oklab, var(--blue-6) 30%, transparent)"},
+		{Color.Primary().Mix(Color.Background(), 0.125), "color-mix(in oklab, var(--primary), var(--background) 12.5%)"},
+		{Color.Blue(6).Lighten(0.1), "oklch(from var(--blue-6) calc(l + 0.1) c h)"},
+		{Color.Blue(6).Darken(0.05), "oklch(from var(--blue-6) calc(l - 0.05) c h)"},
+		{Color.Blue(6).Saturate(0.02), "oklch(from var(--blue-6) l calc(c + 0.02) h)"},
+		{Color.Blue(6).Desaturate(0.02), "oklch(from var(--blue-6) l max(0, calc(c - 0.02)) h)"},
+	}
+	for _, e := range expressions {
+		if e.result != e.expected {
+			t.Errorf("got %q, want %q", e.result, e.expected)
+		}
+		if err := NewStyle().Background(e.result).Err(); err != nil {
+			t.Errorf("Style rejected %q: %v", e.result, err)
+		}
+	}
+}
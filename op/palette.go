@@ -0,0 +1,104 @@
+package op
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// paletteLightness is the average OKLCH lightness of each step of the
+// chromatic Open Props scales (red through jungle)
+var paletteLightness = [13]float64{
+	0.971, 0.933, 0.881, 0.821, 0.766, 0.718, 0.673, 0.628, 0.582, 0.531, 0.467, 0.400, 0.334,
+}
+
+// paletteChroma is the average chroma of each step relative to the most
+// saturated step of its scale
+var paletteChroma = [13]float64{
+	0.171, 0.335, 0.529, 0.720, 0.857, 0.936, 0.971, 0.952, 0.903, 0.832, 0.728, 0.620, 0.506,
+}
+
+// paletteNamePattern restricts palette names to valid custom property names
+var paletteNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// Palette is a 0-12 color scale generated from a single brand color,
+// shaped like the Open Props color scales
+type Palette struct {
+	Name      string     // Custom property prefix, e.g. "brand" for --brand-0 ... --brand-12
+	Scale     [13]string // Hex values from lightest (0) to darkest (12)
+	BrandStep int        // Step that holds the exact brand color
+
+	PrimaryLight      string // --primary in the light theme
+	PrimaryHoverLight string // --primary-hover in the light theme
+	PrimaryDark       string // --primary in the dark theme
+	PrimaryHoverDark  string // --primary-hover in the dark theme
+}
+
+// NewPalette generates a palette named name from a brand color given as
+// hex, rgb() or oklch(). The brand color itself is kept at the step with the
+// closest lightness; the other steps follow the Open Props lightness and
+// chroma curves at the brand hue.
+func NewPalette(name, brand string) (*Palette, error) {
+	if !paletteNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("op: invalid palette name %q", name)
+	}
+	rgb, err := parseColor(brand)
+	if err != nil {
+		return nil, err
+	}
+	base := rgb.toOKLCH()
+
+	// Place the brand color at the step with the nearest lightness
+	step := 0
+	for i, l := range paletteLightness {
+		if math.Abs(l-base.l) < math.Abs(paletteLightness[step]-base.l) {
+			step = i
+		}
+	}
+	peak := base.c / paletteChroma[step]
+
+	p := &Palette{Name: name, BrandStep: step}
+	for i := range p.Scale {
+		if i == step {
+			p.Scale[i] = rgb.hex()
+			continue
+		}
+		p.Scale[i] = oklch{l: paletteLightness[i], c: peak * paletteChroma[i], h: base.h}.toGamut().hex()
+	}
+
+	// Light themes need a darker primary, dark themes a lighter one;
+	// hover is one step darker in both, like the default tokens
+	light := clamp(step, 6, 9)
+	dark := clamp(step, 4, 6)
+	p.PrimaryLight, p.PrimaryHoverLight = p.Scale[light], p.Scale[light+1]
+	p.PrimaryDark, p.PrimaryHoverDark = p.Scale[dark], p.Scale[dark+1]
+
+	return p, nil
+}
+
+// Color returns a palette color variable (--{name}-{0-12})
+func (p *Palette) Color(scale int) ColorValue {
+	return colorScale(p.Name, scale)
+}
+
+// Value returns the literal hex value of a palette step (0-12)
+func (p *Palette) Value(scale int) string {
+	return p.Scale[clamp(scale, 0, 12)]
+}
+
+// CSS returns the palette as custom properties, together with --primary and
+// --primary-hover for the :root light-dark() values and the .light/.dark blocks
+func (p *Palette) CSS() string {
+	var b strings.Builder
+	b.WriteString(":root {\n")
+	for i, value := range p.Scale {
+		fmt.Fprintf(&b, "  --%s-%d: %s;\n", p.Name, i, value)
+	}
+	fmt.Fprintf(&b, "  --primary: light-dark(%s, %s);\n", p.PrimaryLight, p.PrimaryDark)
+	fmt.Fprintf(&b, "  --primary-hover: light-dark(%s, %s);\n", p.PrimaryHoverLight, p.PrimaryHoverDark)
+	b.WriteString("}\n\n")
+	fmt.Fprintf(&b, ".light {\n  --primary: %s;\n  --primary-hover: %s;\n}\n\n", p.PrimaryLight, p.PrimaryHoverLight)
+	fmt.Fprintf(&b, ".dark {\n  --primary: %s;\n  --primary-hover: %s;\n}\n", p.PrimaryDark, p.PrimaryHoverDark)
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package op
+
+import (
+	"sort"
+	"strings"
+)
+
+//go:generate go run ../cmd/generate-tokens -src ../src/index.css -vendor ../src/vendor -semantic ../src/tokens.css -out tokens_gen.go -colors colors_gen.go
+
+// tokenName normalizes "size-4", "--size-4" and "var(--size-4)" to "size-4"
+func tokenName[T ~string](t T) string {
+	token := strings.TrimSpace(string(t))
+	if inner, ok := strings.CutPrefix(token, "var("); ok {
+		token = strings.TrimSuffix(inner, ")")
+	}
+	return strings.TrimPrefix(token, "--")
+}
+
+// Resolve returns the literal CSS value of a token in the default (light)
+// theme, with all var() references substituted and constant calc()
+// arithmetic evaluated. Values that depend on the viewport, such as the
+// --radius-conditional-* clamp(), keep their calc(). It accepts the bare name
+// ("size-4"), the custom property ("--size-4") or the output of an accessor
+// such as Size(4):
+//
+//	op.Resolve(op.Size(4)) // "1.25rem", true
+func Resolve[T ~string](token T) (string, bool) {
+	value, ok := tokenValues[tokenName(token)]
+	return value, ok
+}
+
+// ResolveDark returns the literal CSS value of a token in the dark theme
+func ResolveDark[T ~string](token T) (string, bool) {
+	name := tokenName(token)
+	if value, ok := darkTokenValues[name]; ok {
+		return value, true
+	}
+	value, ok := tokenValues[name]
+	return value, ok
+}
+
+// Tokens returns the names of all resolvable tokens in sorted order
+func Tokens() []string {
+	names := make([]string, 0, len(tokenValues))
+	for name := range tokenValues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,116 @@
+package op
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	// customPropertyNamePattern matches custom property names such as --brand-5
+	customPropertyNamePattern = regexp.MustCompile(`^--[A-Za-z0-9_-]+$`)
+
+	// vendorPropertyPattern matches vendor-prefixed properties such as -webkit-line-clamp
+	vendorPropertyPattern = regexp.MustCompile(`^-(webkit|moz|ms|o)-[a-z]+(-[a-z]+)*$`)
+
+	// urlPattern matches url() references with a quoted or unquoted argument
+	urlPattern = regexp.MustCompile(`(?i)url\(\s*(?:"([^"]*)"|'([^']*)'|([^"'()\s]*))\s*\)`)
+
+	// dataImagePattern matches the data: URLs accepted in url(), which browsers
+	// never execute as script when used as a CSS image
+	dataImagePattern = regexp.MustCompile(`(?i)^data:image/(png|gif|jpeg|webp|avif|svg\+xml)[;,]`)
+)
+
+// unsafeValueParts are fragments of legacy script-running CSS, matched
+// case-insensitively with whitespace removed
+var unsafeValueParts = []string{"expression(", "javascript:", "vbscript:", "behavior:", "-moz-binding"}
+
+// checkDeclaration reports why a property: value pair cannot be added to a
+// Style. Values must not be empty, end the declaration or the attribute,
+// open a comment, or load script.
+func checkDeclaration(property, value string) error {
+	if !knownProperties[property] && !customPropertyNamePattern.MatchString(property) && !vendorPropertyPattern.MatchString(property) {
+		return fmt.Errorf("op: unknown CSS property %q", property)
+	}
+	if value == "" {
+		return fmt.Errorf("op: %s value is empty", property)
+	}
+
+	unsafe := func(format string, args ...any) error {
+		return fmt.Errorf("op: %s value %q %s", property, value, fmt.Sprintf(format, args...))
+	}
+	for _, r := range value {
+		if (r < ' ' && r != '\t') || r == 0x7f {
+			return unsafe("contains a control character")
+		}
+	}
+	if i := strings.IndexAny(value, ";{}<>\\"); i >= 0 {
+		return unsafe("contains %q", value[i])
+	}
+	if strings.Contains(value, "/*") || strings.Contains(value, "*/") {
+		return unsafe("contains a comment")
+	}
+	if !balanced(value) {
+		return unsafe("has unbalanced quotes or brackets")
+	}
+
+	compact := strings.ToLower(strings.Join(strings.Fields(value), ""))
+	for _, part := range unsafeValueParts {
+		if strings.Contains(compact, part) {
+			return unsafe("contains %s", part)
+		}
+	}
+
+	urls := urlPattern.FindAllStringSubmatch(value, -1)
+	if len(urls) != strings.Count(strings.ToLower(value), "url(") {
+		return unsafe("contains a malformed url()")
+	}
+	for _, m := range urls {
+		if u := m[1] + m[2] + m[3]; !safeURL(u) {
+			return unsafe("contains unsafe URL %q", u)
+		}
+	}
+	return nil
+}
+
+// balanced reports whether every quote is closed and every parenthesis or
+// bracket outside a string is matched
+func balanced(value string) bool {
+	var quote rune
+	var open []rune
+	for _, r := range value {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '(' || r == '[':
+			open = append(open, r)
+		case r == ')' || r == ']':
+			if len(open) == 0 || (open[len(open)-1] == '(') != (r == ')') {
+				return false
+			}
+			open = open[:len(open)-1]
+		}
+	}
+	return quote == 0 && len(open) == 0
+}
+
+// safeURL reports whether u is a relative, http(s) or data:image URL
+func safeURL(u string) bool {
+	if dataImagePattern.MatchString(u) {
+		return true
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "", "http", "https":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,385 @@
+package op
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Strict provides the scale accessors in a checked form. Instead of clamping
+// an out-of-range argument to the nearest token, each method returns an error
+// naming the valid range:
+//
+//	op.Size(20)              // "var(--size-15)"
+//	op.Strict.Size(20)       // "", op: Size(20) is out of range, valid range is -2, -1, 1-15
+//	op.Strict.Color.Blue(-3) // "", op: Color.Blue(-3) is out of range, valid range is 0-12
+//
+// Successful calls return exactly what the lenient accessor returns.
+var Strict = &strict{
+	Color:  &strictColors{},
+	Font:   &strictFont{},
+	Border: &strictBorder{},
+	Ease:   &strictEase{},
+}
+
+type strict struct {
+	Color  *strictColors
+	Font   *strictFont
+	Border *strictBorder
+	Ease   *strictEase
+}
+
+type strictColors struct{}
+type strictFont struct{}
+type strictBorder struct{}
+type strictEase struct{}
+
+// scaleRange describes the valid arguments of a scale accessor
+type scaleRange struct {
+	min, max int
+	extra    []int // Valid values below min, e.g. -2 and -1 for size-000 and size-00
+}
+
+// Valid ranges of the size accessors, which map -2 and -1 to the 000 and 00 steps
+var (
+	rangeSize   = sizeRange("size")
+	rangeSizePx = sizeRange("size-px")
+)
+
+// tokenRanges maps each numbered token family, such as "size" or
+// "ease-elastic-out", to the numbers it has in the generated token table,
+// so the strict ranges follow the stylesheet
+var tokenRanges = scanRanges(tokenValues)
+
+// scanRanges collects the numeric suffixes of the names in tokens by family
+func scanRanges(tokens map[string]string) map[string]scaleRange {
+	ranges := make(map[string]scaleRange)
+	for name := range tokens {
+		i := strings.LastIndexByte(name, '-')
+		if i <= 0 {
+			continue
+		}
+		n, err := strconv.Atoi(name[i+1:])
+		if err != nil || strconv.Itoa(n) != name[i+1:] {
+			continue // Not a number, or a step such as size-00
+		}
+		r, ok := ranges[name[:i]]
+		if !ok {
+			r = scaleRange{min: n, max: n}
+		}
+		ranges[name[:i]] = scaleRange{min: min(r.min, n), max: max(r.max, n)}
+	}
+	return ranges
+}
+
+// tokenRange returns the range of a token family, which is empty if the
+// token table has no such family
+func tokenRange(family string) scaleRange {
+	if r, ok := tokenRanges[family]; ok {
+		return r
+	}
+	return scaleRange{min: 1, max: 0}
+}
+
+// sizeRange returns the range of a size family with the -2 and -1 arguments
+// for its 000 and 00 steps
+func sizeRange(family string) scaleRange {
+	r := tokenRange(family)
+	for _, step := range []struct {
+		n      int
+		suffix string
+	}{{-2, "000"}, {-1, "00"}} {
+		if _, ok := tokenValues[family+"-"+step.suffix]; ok {
+			r.extra = append(r.extra, step.n)
+		}
+	}
+	return r
+}
+
+// contains reports whether n is a valid argument
+func (r scaleRange) contains(n int) bool {
+	for _, v := range r.extra {
+		if n == v {
+			return true
+		}
+	}
+	return n >= r.min && n <= r.max
+}
+
+// String formats the range for error messages, e.g. "-2, -1, 1-15"
+func (r scaleRange) String() string {
+	var parts []string
+	for _, v := range r.extra {
+		parts = append(parts, fmt.Sprint(v))
+	}
+	if r.min <= r.max {
+		parts = append(parts, fmt.Sprintf("%d-%d", r.min, r.max))
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// checked calls fn if n is within r and reports an error naming the range otherwise
+func checked[T ~string](name string, n int, r scaleRange, fn func(int) T) (T, error) {
+	if !r.contains(n) {
+		return "", fmt.Errorf("op: %s(%d) is out of range, valid range is %s", name, n, r)
+	}
+	return fn(n), nil
+}
+
+// Size returns a size variable (--size-{n}), valid for -2, -1 and 1-15
+func (s *strict) Size(n int) (Length, error) {
+	return checked("Size", n, rangeSize, Size)
+}
+
+// SizePx returns a pixel-based size variable (--size-px-{n}), valid for -2, -1 and 1-15
+func (s *strict) SizePx(n int) (Length, error) {
+	return checked("SizePx", n, rangeSizePx, SizePx)
+}
+
+// SizeFluid returns a fluid size variable (--size-fluid-{1-10})
+func (s *strict) SizeFluid(n int) (Length, error) {
+	return checked("SizeFluid", n, tokenRange("size-fluid"), SizeFluid)
+}
+
+// SizeContent returns a content size variable (--size-content-{1-3})
+func (s *strict) SizeContent(n int) (Length, error) {
+	return checked("SizeContent", n, tokenRange("size-content"), SizeContent)
+}
+
+// SizeHeader returns a header size variable (--size-header-{1-3})
+func (s *strict) SizeHeader(n int) (Length, error) {
+	return checked("SizeHeader", n, tokenRange("size-header"), SizeHeader)
+}
+
+// Shadow returns a shadow variable (--shadow-{1-6})
+func (s *strict) Shadow(n int) (ShadowValue, error) {
+	return checked("Shadow", n, tokenRange("shadow"), Shadow)
+}
+
+// InnerShadow returns an inner shadow variable (--inner-shadow-{0-4})
+func (s *strict) InnerShadow(n int) (ShadowValue, error) {
+	return checked("InnerShadow", n, tokenRange("inner-shadow"), InnerShadow)
+}
+
+// Gradient returns a gradient variable (--gradient-{1-30})
+func (s *strict) Gradient(n int) (GradientValue, error) {
+	return checked("Gradient", n, tokenRange("gradient"), Gradient)
+}
+
+// Layer returns a layer/z-index variable (--layer-{1-5})
+func (s *strict) Layer(n int) (Number, error) {
+	return checked("Layer", n, tokenRange("layer"), Layer)
+}
+
+// Radius returns a radius variable (--radius-{1-6})
+func (s *strict) Radius(n int) (Length, error) {
+	return checked("Radius", n, tokenRange("radius"), Radius)
+}
+
+// RadiusBlob returns a blob radius variable (--radius-blob-{1-5})
+func (s *strict) RadiusBlob(n int) (Length, error) {
+	return checked("RadiusBlob", n, tokenRange("radius-blob"), RadiusBlob)
+}
+
+// RadiusConditional returns a conditional radius variable (--radius-conditional-{1-6})
+func (s *strict) RadiusConditional(n int) (Length, error) {
+	return checked("RadiusConditional", n, tokenRange("radius-conditional"), RadiusConditional)
+}
+
+// Ratio returns an aspect ratio variable and rejects unknown names
+func (s *strict) Ratio(name string) (Number, error) {
+	for _, valid := range ratioNames {
+		if name == valid {
+			return Ratio(name), nil
+		}
+	}
+	return "", fmt.Errorf("op: Ratio(%q) is unknown, valid names are %s", name, strings.Join(ratioNames, ", "))
+}
+
+// Size returns a border size variable (--border-size-{1-5})
+func (b *strictBorder) Size(n int) (Length, error) {
+	return checked("Border.Size", n, tokenRange("border-size"), Border.Size)
+}
+
+// Size returns a font size variable (--font-size-{0-8})
+func (f *strictFont) Size(n int) (Length, error) {
+	return checked("Font.Size", n, tokenRange("font-size"), Font.Size)
+}
+
+// SizeFluid returns a fluid font size variable (--font-size-fluid-{0-3})
+func (f *strictFont) SizeFluid(n int) (Length, error) {
+	return checked("Font.SizeFluid", n, tokenRange("font-size-fluid"), Font.SizeFluid)
+}
+
+// Weight returns a font weight variable (--font-weight-{1-9})
+func (f *strictFont) Weight(n int) (FontWeight, error) {
+	return checked("Font.Weight", n, tokenRange("font-weight"), Font.Weight)
+}
+
+// LineHeight returns a line height variable (--font-lineheight-{0-5})
+func (f *strictFont) LineHeight(n int) (Number, error) {
+	return checked("Font.LineHeight", n, tokenRange("font-lineheight"), Font.LineHeight)
+}
+
+// LetterSpacing returns a letter spacing variable (--font-letterspacing-{0-7})
+func (f *strictFont) LetterSpacing(n int) (Length, error) {
+	return checked("Font.LetterSpacing", n, tokenRange("font-letterspacing"), Font.LetterSpacing)
+}
+
+// Gray returns a gray color variable (--gray-{0-12})
+func (c *strictColors) Gray(n int) (ColorValue, error) {
+	return checked("Color.Gray", n, tokenRange("gray"), Color.Gray)
+}
+
+// Stone returns a stone color variable (--stone-{0-12})
+func (c *strictColors) Stone(n int) (ColorValue, error) {
+	return checked("Color.Stone", n, tokenRange("stone"), Color.Stone)
+}
+
+// Red returns a red color variable (--red-{0-12})
+func (c *strictColors) Red(n int) (ColorValue, error) {
+	return checked("Color.Red", n, tokenRange("red"), Color.Red)
+}
+
+// Pink returns a pink color variable (--pink-{0-12})
+func (c *strictColors) Pink(n int) (ColorValue, error) {
+	return checked("Color.Pink", n, tokenRange("pink"), Color.Pink)
+}
+
+// Purple returns a purple color variable (--purple-{0-12})
+func (c *strictColors) Purple(n int) (ColorValue, error) {
+	return checked("Color.Purple", n, tokenRange("purple"), Color.Purple)
+}
+
+// Violet returns a violet color variable (--violet-{0-12})
+func (c *strictColors) Violet(n int) (ColorValue, error) {
+	return checked("Color.Violet", n, tokenRange("violet"), Color.Violet)
+}
+
+// Indigo returns an indigo color variable (--indigo-{0-12})
+func (c *strictColors) Indigo(n int) (ColorValue, error) {
+	return checked("Color.Indigo", n, tokenRange("indigo"), Color.Indigo)
+}
+
+// Blue returns a blue color variable (--blue-{0-12})
+func (c *strictColors) Blue(n int) (ColorValue, error) {
+	return checked("Color.Blue", n, tokenRange("blue"), Color.Blue)
+}
+
+// Cyan returns a cyan color variable (--cyan-{0-12})
+func (c *strictColors) Cyan(n int) (ColorValue, error) {
+	return checked("Color.Cyan", n, tokenRange("cyan"), Color.Cyan)
+}
+
+// Teal returns a teal color variable (--teal-{0-12})
+func (c *strictColors) Teal(n int) (ColorValue, error) {
+	return checked("Color.Teal", n, tokenRange("teal"), Color.Teal)
+}
+
+// Green returns a green color variable (--green-{0-12})
+func (c *strictColors) Green(n int) (ColorValue, error) {
+	return checked("Color.Green", n, tokenRange("green"), Color.Green)
+}
+
+// Lime returns a lime color variable (--lime-{0-12})
+func (c *strictColors) Lime(n int) (ColorValue, error) {
+	return checked("Color.Lime", n, tokenRange("lime"), Color.Lime)
+}
+
+// Yellow returns a yellow color variable (--yellow-{0-12})
+func (c *strictColors) Yellow(n int) (ColorValue, error) {
+	return checked("Color.Yellow", n, tokenRange("yellow"), Color.Yellow)
+}
+
+// Orange returns an orange color variable (--orange-{0-12})
+func (c *strictColors) Orange(n int) (ColorValue, error) {
+	return checked("Color.Orange", n, tokenRange("orange"), Color.Orange)
+}
+
+// Choco returns a choco color variable (--choco-{0-12})
+func (c *strictColors) Choco(n int) (ColorValue, error) {
+	return checked("Color.Choco", n, tokenRange("choco"), Color.Choco)
+}
+
+// Brown returns a brown color variable (--brown-{0-12})
+func (c *strictColors) Brown(n int) (ColorValue, error) {
+	return checked("Color.Brown", n, tokenRange("brown"), Color.Brown)
+}
+
+// Sand returns a sand color variable (--sand-{0-12})
+func (c *strictColors) Sand(n int) (ColorValue, error) {
+	return checked("Color.Sand", n, tokenRange("sand"), Color.Sand)
+}
+
+// Camo returns a camo color variable (--camo-{0-12})
+func (c *strictColors) Camo(n int) (ColorValue, error) {
+	return checked("Color.Camo", n, tokenRange("camo"), Color.Camo)
+}
+
+// Jungle returns a jungle color variable (--jungle-{0-12})
+func (c *strictColors) Jungle(n int) (ColorValue, error) {
+	return checked("Color.Jungle", n, tokenRange("jungle"), Color.Jungle)
+}
+
+// Default returns a default ease variable (--ease-{1-5})
+func (e *strictEase) Default(n int) (Easing, error) {
+	return checked("Ease.Default", n, tokenRange("ease"), Ease.Default)
+}
+
+// In returns an ease-in variable (--ease-in-{1-5})
+func (e *strictEase) In(n int) (Easing, error) {
+	return checked("Ease.In", n, tokenRange("ease-in"), Ease.In)
+}
+
+// Out returns an ease-out variable (--ease-out-{1-5})
+func (e *strictEase) Out(n int) (Easing, error) {
+	return checked("Ease.Out", n, tokenRange("ease-out"), Ease.Out)
+}
+
+// InOut returns an ease-in-out variable (--ease-in-out-{1-5})
+func (e *strictEase) InOut(n int) (Easing, error) {
+	return checked("Ease.InOut", n, tokenRange("ease-in-out"), Ease.InOut)
+}
+
+// Elastic returns an elastic ease variable (--ease-elastic-{1-5})
+func (e *strictEase) Elastic(n int) (Easing, error) {
+	return checked("Ease.Elastic", n, tokenRange("ease-elastic"), Ease.Elastic)
+}
+
+// ElasticOut returns an elastic-out ease variable (--ease-elastic-out-{1-5})
+func (e *strictEase) ElasticOut(n int) (Easing, error) {
+	return checked("Ease.ElasticOut", n, tokenRange("ease-elastic-out"), Ease.ElasticOut)
+}
+
+// ElasticIn returns an elastic-in ease variable (--ease-elastic-in-{1-5})
+func (e *strictEase) ElasticIn(n int) (Easing, error) {
+	return checked("Ease.ElasticIn", n, tokenRange("ease-elastic-in"), Ease.ElasticIn)
+}
+
+// ElasticInOut returns an elastic-in-out ease variable (--ease-elastic-in-out-{1-5})
+func (e *strictEase) ElasticInOut(n int) (Easing, error) {
+	return checked("Ease.ElasticInOut", n, tokenRange("ease-elastic-in-out"), Ease.ElasticInOut)
+}
+
+// Squish returns a squish ease variable (--ease-squish-{1-5})
+func (e *strictEase) Squish(n int) (Easing, error) {
+	return checked("Ease.Squish", n, tokenRange("ease-squish"), Ease.Squish)
+}
+
+// Step returns a step ease variable (--ease-step-{1-5})
+func (e *strictEase) Step(n int) (Easing, error) {
+	return checked("Ease.Step", n, tokenRange("ease-step"), Ease.Step)
+}
+
+// Spring returns a spring ease variable (--ease-spring-{1-5})
+func (e *strictEase) Spring(n int) (Easing, error) {
+	return checked("Ease.Spring", n, tokenRange("ease-spring"), Ease.Spring)
+}
+
+// Bounce returns a bounce ease variable (--ease-bounce-{1-5})
+func (e *strictEase) Bounce(n int) (Easing, error) {
+	return checked("Ease.Bounce", n, tokenRange("ease-bounce"), Ease.Bounce)
+}
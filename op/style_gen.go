@@ -0,0 +1,1214 @@
+// Code generated by cmd/generate-style from cmd/generate-style/properties.txt. DO NOT EDIT.
+
+package op
+
+// knownProperties are the properties accepted by Style.Custom, besides custom
+// properties and vendor-prefixed names
+var knownProperties = map[string]bool{
+	"background":                 true,
+	"background-color":           true,
+	"background-image":           true,
+	"background-size":            true,
+	"background-position":        true,
+	"background-repeat":          true,
+	"background-clip":            true,
+	"background-attachment":      true,
+	"color":                      true,
+	"accent-color":               true,
+	"caret-color":                true,
+	"opacity":                    true,
+	"display":                    true,
+	"visibility":                 true,
+	"box-sizing":                 true,
+	"float":                      true,
+	"clear":                      true,
+	"isolation":                  true,
+	"contain":                    true,
+	"content-visibility":         true,
+	"aspect-ratio":               true,
+	"object-fit":                 true,
+	"object-position":            true,
+	"flex":                       true,
+	"flex-direction":             true,
+	"flex-wrap":                  true,
+	"flex-flow":                  true,
+	"flex-grow":                  true,
+	"flex-shrink":                true,
+	"flex-basis":                 true,
+	"order":                      true,
+	"grid":                       true,
+	"grid-template":              true,
+	"grid-template-columns":      true,
+	"grid-template-rows":         true,
+	"grid-template-areas":        true,
+	"grid-auto-columns":          true,
+	"grid-auto-rows":             true,
+	"grid-auto-flow":             true,
+	"grid-area":                  true,
+	"grid-column":                true,
+	"grid-column-start":          true,
+	"grid-column-end":            true,
+	"grid-row":                   true,
+	"grid-row-start":             true,
+	"grid-row-end":               true,
+	"gap":                        true,
+	"row-gap":                    true,
+	"column-gap":                 true,
+	"justify-content":            true,
+	"justify-items":              true,
+	"justify-self":               true,
+	"align-content":              true,
+	"align-items":                true,
+	"align-self":                 true,
+	"place-content":              true,
+	"place-items":                true,
+	"place-self":                 true,
+	"position":                   true,
+	"inset":                      true,
+	"top":                        true,
+	"right":                      true,
+	"bottom":                     true,
+	"left":                       true,
+	"inset-inline":               true,
+	"inset-inline-start":         true,
+	"inset-inline-end":           true,
+	"inset-block":                true,
+	"inset-block-start":          true,
+	"inset-block-end":            true,
+	"z-index":                    true,
+	"width":                      true,
+	"height":                     true,
+	"min-width":                  true,
+	"max-width":                  true,
+	"min-height":                 true,
+	"max-height":                 true,
+	"inline-size":                true,
+	"block-size":                 true,
+	"min-inline-size":            true,
+	"max-inline-size":            true,
+	"min-block-size":             true,
+	"max-block-size":             true,
+	"padding":                    true,
+	"padding-top":                true,
+	"padding-right":              true,
+	"padding-bottom":             true,
+	"padding-left":               true,
+	"padding-inline":             true,
+	"padding-inline-start":       true,
+	"padding-inline-end":         true,
+	"padding-block":              true,
+	"padding-block-start":        true,
+	"padding-block-end":          true,
+	"margin":                     true,
+	"margin-top":                 true,
+	"margin-right":               true,
+	"margin-bottom":              true,
+	"margin-left":                true,
+	"margin-inline":              true,
+	"margin-inline-start":        true,
+	"margin-inline-end":          true,
+	"margin-block":               true,
+	"margin-block-start":         true,
+	"margin-block-end":           true,
+	"border":                     true,
+	"border-width":               true,
+	"border-style":               true,
+	"border-color":               true,
+	"border-top":                 true,
+	"border-right":               true,
+	"border-bottom":              true,
+	"border-left":                true,
+	"border-inline":              true,
+	"border-inline-start":        true,
+	"border-inline-end":          true,
+	"border-block":               true,
+	"border-block-start":         true,
+	"border-block-end":           true,
+	"border-radius":              true,
+	"border-top-left-radius":     true,
+	"border-top-right-radius":    true,
+	"border-bottom-right-radius": true,
+	"border-bottom-left-radius":  true,
+	"border-start-start-radius":  true,
+	"border-start-end-radius":    true,
+	"border-end-start-radius":    true,
+	"border-end-end-radius":      true,
+	"border-collapse":            true,
+	"border-spacing":             true,
+	"outline":                    true,
+	"outline-width":              true,
+	"outline-style":              true,
+	"outline-color":              true,
+	"outline-offset":             true,
+	"overflow":                   true,
+	"overflow-x":                 true,
+	"overflow-y":                 true,
+	"overflow-wrap":              true,
+	"overscroll-behavior":        true,
+	"scroll-behavior":            true,
+	"scrollbar-gutter":           true,
+	"scroll-margin":              true,
+	"scroll-padding":             true,
+	"scroll-snap-type":           true,
+	"scroll-snap-align":          true,
+	"font":                       true,
+	"font-family":                true,
+	"font-size":                  true,
+	"font-weight":                true,
+	"font-style":                 true,
+	"line-height":                true,
+	"letter-spacing":             true,
+	"text-align":                 true,
+	"text-decoration":            true,
+	"text-decoration-color":      true,
+	"text-transform":             true,
+	"text-overflow":              true,
+	"text-wrap":                  true,
+	"text-indent":                true,
+	"text-shadow":                true,
+	"white-space":                true,
+	"word-break":                 true,
+	"vertical-align":             true,
+	"hyphens":                    true,
+	"list-style":                 true,
+	"content":                    true,
+	"box-shadow":                 true,
+	"filter":                     true,
+	"backdrop-filter":            true,
+	"mix-blend-mode":             true,
+	"transform":                  true,
+	"transform-origin":           true,
+	"translate":                  true,
+	"rotate":                     true,
+	"scale":                      true,
+	"clip-path":                  true,
+	"will-change":                true,
+	"cursor":                     true,
+	"pointer-events":             true,
+	"user-select":                true,
+	"touch-action":               true,
+	"resize":                     true,
+	"appearance":                 true,
+	"transition":                 true,
+	"transition-property":        true,
+	"transition-duration":        true,
+	"transition-timing-function": true,
+	"transition-delay":           true,
+	"animation":                  true,
+	"animation-name":             true,
+	"animation-duration":         true,
+	"animation-timing-function":  true,
+	"animation-delay":            true,
+	"animation-iteration-count":  true,
+	"animation-direction":        true,
+	"animation-fill-mode":        true,
+	"animation-play-state":       true,
+	"container":                  true,
+	"container-type":             true,
+	"container-name":             true,
+}
+
+// Background adds a background property
+func (s *Style) Background(value BackgroundValue) *Style {
+	return s.add("background", stringOf(value))
+}
+
+// BackgroundColor adds a background-color property
+func (s *Style) BackgroundColor(value ColorValue) *Style {
+	return s.add("background-color", string(value))
+}
+
+// BackgroundImage adds a background-image property
+func (s *Style) BackgroundImage(value GradientValue) *Style {
+	return s.add("background-image", string(value))
+}
+
+// BackgroundSize adds a background-size property
+func (s *Style) BackgroundSize(value string) *Style {
+	return s.add("background-size", value)
+}
+
+// BackgroundPosition adds a background-position property
+func (s *Style) BackgroundPosition(value string) *Style {
+	return s.add("background-position", value)
+}
+
+// BackgroundRepeat adds a background-repeat property
+func (s *Style) BackgroundRepeat(value string) *Style {
+	return s.add("background-repeat", value)
+}
+
+// BackgroundClip adds a background-clip property
+func (s *Style) BackgroundClip(value string) *Style {
+	return s.add("background-clip", value)
+}
+
+// BackgroundAttachment adds a background-attachment property
+func (s *Style) BackgroundAttachment(value string) *Style {
+	return s.add("background-attachment", value)
+}
+
+// Color adds a color property
+func (s *Style) Color(value ColorValue) *Style {
+	return s.add("color", string(value))
+}
+
+// AccentColor adds a accent-color property
+func (s *Style) AccentColor(value ColorValue) *Style {
+	return s.add("accent-color", string(value))
+}
+
+// CaretColor adds a caret-color property
+func (s *Style) CaretColor(value ColorValue) *Style {
+	return s.add("caret-color", string(value))
+}
+
+// Opacity adds a opacity property
+func (s *Style) Opacity(value Number) *Style {
+	return s.add("opacity", string(value))
+}
+
+// Display adds a display property
+func (s *Style) Display(value string) *Style {
+	return s.add("display", value)
+}
+
+// Visibility adds a visibility property
+func (s *Style) Visibility(value string) *Style {
+	return s.add("visibility", value)
+}
+
+// BoxSizing adds a box-sizing property
+func (s *Style) BoxSizing(value string) *Style {
+	return s.add("box-sizing", value)
+}
+
+// Float adds a float property
+func (s *Style) Float(value string) *Style {
+	return s.add("float", value)
+}
+
+// Clear adds a clear property
+func (s *Style) Clear(value string) *Style {
+	return s.add("clear", value)
+}
+
+// Isolation adds a isolation property
+func (s *Style) Isolation(value string) *Style {
+	return s.add("isolation", value)
+}
+
+// Contain adds a contain property
+func (s *Style) Contain(value string) *Style {
+	return s.add("contain", value)
+}
+
+// ContentVisibility adds a content-visibility property
+func (s *Style) ContentVisibility(value string) *Style {
+	return s.add("content-visibility", value)
+}
+
+// AspectRatio adds a aspect-ratio property
+func (s *Style) AspectRatio(value Number) *Style {
+	return s.add("aspect-ratio", string(value))
+}
+
+// ObjectFit adds a object-fit property
+func (s *Style) ObjectFit(value string) *Style {
+	return s.add("object-fit", value)
+}
+
+// ObjectPosition adds a object-position property
+func (s *Style) ObjectPosition(value string) *Style {
+	return s.add("object-position", value)
+}
+
+// Flex adds a flex property
+func (s *Style) Flex(value string) *Style {
+	return s.add("flex", value)
+}
+
+// FlexDirection adds a flex-direction property
+func (s *Style) FlexDirection(value string) *Style {
+	return s.add("flex-direction", value)
+}
+
+// FlexWrap adds a flex-wrap property
+func (s *Style) FlexWrap(value string) *Style {
+	return s.add("flex-wrap", value)
+}
+
+// FlexFlow adds a flex-flow property
+func (s *Style) FlexFlow(value string) *Style {
+	return s.add("flex-flow", value)
+}
+
+// FlexGrow adds a flex-grow property
+func (s *Style) FlexGrow(value Number) *Style {
+	return s.add("flex-grow", string(value))
+}
+
+// FlexShrink adds a flex-shrink property
+func (s *Style) FlexShrink(value Number) *Style {
+	return s.add("flex-shrink", string(value))
+}
+
+// FlexBasis adds a flex-basis property
+func (s *Style) FlexBasis(value Length) *Style {
+	return s.add("flex-basis", string(value))
+}
+
+// Order adds a order property
+func (s *Style) Order(value Number) *Style {
+	return s.add("order", string(value))
+}
+
+// Grid adds a grid property
+func (s *Style) Grid(value string) *Style {
+	return s.add("grid", value)
+}
+
+// GridTemplate adds a grid-template property
+func (s *Style) GridTemplate(value string) *Style {
+	return s.add("grid-template", value)
+}
+
+// GridTemplateColumns adds a grid-template-columns property
+func (s *Style) GridTemplateColumns(value string) *Style {
+	return s.add("grid-template-columns", value)
+}
+
+// GridTemplateRows adds a grid-template-rows property
+func (s *Style) GridTemplateRows(value string) *Style {
+	return s.add("grid-template-rows", value)
+}
+
+// GridTemplateAreas adds a grid-template-areas property
+func (s *Style) GridTemplateAreas(value string) *Style {
+	return s.add("grid-template-areas", value)
+}
+
+// GridAutoColumns adds a grid-auto-columns property
+func (s *Style) GridAutoColumns(value string) *Style {
+	return s.add("grid-auto-columns", value)
+}
+
+// GridAutoRows adds a grid-auto-rows property
+func (s *Style) GridAutoRows(value string) *Style {
+	return s.add("grid-auto-rows", value)
+}
+
+// GridAutoFlow adds a grid-auto-flow property
+func (s *Style) GridAutoFlow(value string) *Style {
+	return s.add("grid-auto-flow", value)
+}
+
+// GridArea adds a grid-area property
+func (s *Style) GridArea(value string) *Style {
+	return s.add("grid-area", value)
+}
+
+// GridColumn adds a grid-column property
+func (s *Style) GridColumn(value string) *Style {
+	return s.add("grid-column", value)
+}
+
+// GridColumnStart adds a grid-column-start property
+func (s *Style) GridColumnStart(value string) *Style {
+	return s.add("grid-column-start", value)
+}
+
+// GridColumnEnd adds a grid-column-end property
+func (s *Style) GridColumnEnd(value string) *Style {
+	return s.add("grid-column-end", value)
+}
+
+// GridRow adds a grid-row property
+func (s *Style) GridRow(value string) *Style {
+	return s.add("grid-row", value)
+}
+
+// GridRowStart adds a grid-row-start property
+func (s *Style) GridRowStart(value string) *Style {
+	return s.add("grid-row-start", value)
+}
+
+// GridRowEnd adds a grid-row-end property
+func (s *Style) GridRowEnd(value string) *Style {
+	return s.add("grid-row-end", value)
+}
+
+// Gap adds a gap property
+func (s *Style) Gap(value Length) *Style {
+	return s.add("gap", string(value))
+}
+
+// RowGap adds a row-gap property
+func (s *Style) RowGap(value Length) *Style {
+	return s.add("row-gap", string(value))
+}
+
+// ColumnGap adds a column-gap property
+func (s *Style) ColumnGap(value Length) *Style {
+	return s.add("column-gap", string(value))
+}
+
+// JustifyContent adds a justify-content property
+func (s *Style) JustifyContent(value string) *Style {
+	return s.add("justify-content", value)
+}
+
+// JustifyItems adds a justify-items property
+func (s *Style) JustifyItems(value string) *Style {
+	return s.add("justify-items", value)
+}
+
+// JustifySelf adds a justify-self property
+func (s *Style) JustifySelf(value string) *Style {
+	return s.add("justify-self", value)
+}
+
+// AlignContent adds a align-content property
+func (s *Style) AlignContent(value string) *Style {
+	return s.add("align-content", value)
+}
+
+// AlignItems adds a align-items property
+func (s *Style) AlignItems(value string) *Style {
+	return s.add("align-items", value)
+}
+
+// AlignSelf adds a align-self property
+func (s *Style) AlignSelf(value string) *Style {
+	return s.add("align-self", value)
+}
+
+// PlaceContent adds a place-content property
+func (s *Style) PlaceContent(value string) *Style {
+	return s.add("place-content", value)
+}
+
+// PlaceItems adds a place-items property
+func (s *Style) PlaceItems(value string) *Style {
+	return s.add("place-items", value)
+}
+
+// PlaceSelf adds a place-self property
+func (s *Style) PlaceSelf(value string) *Style {
+	return s.add("place-self", value)
+}
+
+// Position adds a position property
+func (s *Style) Position(value string) *Style {
+	return s.add("position", value)
+}
+
+// Inset adds a inset property
+func (s *Style) Inset(value Length) *Style {
+	return s.add("inset", string(value))
+}
+
+// Top adds a top property
+func (s *Style) Top(value Length) *Style {
+	return s.add("top", string(value))
+}
+
+// Right adds a right property
+func (s *Style) Right(value Length) *Style {
+	return s.add("right", string(value))
+}
+
+// Bottom adds a bottom property
+func (s *Style) Bottom(value Length) *Style {
+	return s.add("bottom", string(value))
+}
+
+// Left adds a left property
+func (s *Style) Left(value Length) *Style {
+	return s.add("left", string(value))
+}
+
+// InsetInline adds a inset-inline property
+func (s *Style) InsetInline(value Length) *Style {
+	return s.add("inset-inline", string(value))
+}
+
+// InsetInlineStart adds a inset-inline-start property
+func (s *Style) InsetInlineStart(value Length) *Style {
+	return s.add("inset-inline-start", string(value))
+}
+
+// InsetInlineEnd adds a inset-inline-end property
+func (s *Style) InsetInlineEnd(value Length) *Style {
+	return s.add("inset-inline-end", string(value))
+}
+
+// InsetBlock adds a inset-block property
+func (s *Style) InsetBlock(value Length) *Style {
+	return s.add("inset-block", string(value))
+}
+
+// InsetBlockStart adds a inset-block-start property
+func (s *Style) InsetBlockStart(value Length) *Style {
+	return s.add("inset-block-start", string(value))
+}
+
+// InsetBlockEnd adds a inset-block-end property
+func (s *Style) InsetBlockEnd(value Length) *Style {
+	return s.add("inset-block-end", string(value))
+}
+
+// ZIndex adds a z-index property
+func (s *Style) ZIndex(value Number) *Style {
+	return s.add("z-index", string(value))
+}
+
+// Width adds a width property
+func (s *Style) Width(value Length) *Style {
+	return s.add("width", string(value))
+}
+
+// Height adds a height property
+func (s *Style) Height(value Length) *Style {
+	return s.add("height", string(value))
+}
+
+// MinWidth adds a min-width property
+func (s *Style) MinWidth(value Length) *Style {
+	return s.add("min-width", string(value))
+}
+
+// MaxWidth adds a max-width property
+func (s *Style) MaxWidth(value Length) *Style {
+	return s.add("max-width", string(value))
+}
+
+// MinHeight adds a min-height property
+func (s *Style) MinHeight(value Length) *Style {
+	return s.add("min-height", string(value))
+}
+
+// MaxHeight adds a max-height property
+func (s *Style) MaxHeight(value Length) *Style {
+	return s.add("max-height", string(value))
+}
+
+// InlineSize adds a inline-size property
+func (s *Style) InlineSize(value Length) *Style {
+	return s.add("inline-size", string(value))
+}
+
+// BlockSize adds a block-size property
+func (s *Style) BlockSize(value Length) *Style {
+	return s.add("block-size", string(value))
+}
+
+// MinInlineSize adds a min-inline-size property
+func (s *Style) MinInlineSize(value Length) *Style {
+	return s.add("min-inline-size", string(value))
+}
+
+// MaxInlineSize adds a max-inline-size property
+func (s *Style) MaxInlineSize(value Length) *Style {
+	return s.add("max-inline-size", string(value))
+}
+
+// MinBlockSize adds a min-block-size property
+func (s *Style) MinBlockSize(value Length) *Style {
+	return s.add("min-block-size", string(value))
+}
+
+// MaxBlockSize adds a max-block-size property
+func (s *Style) MaxBlockSize(value Length) *Style {
+	return s.add("max-block-size", string(value))
+}
+
+// Padding adds a padding property
+func (s *Style) Padding(value Length) *Style {
+	return s.add("padding", string(value))
+}
+
+// PaddingTop adds a padding-top property
+func (s *Style) PaddingTop(value Length) *Style {
+	return s.add("padding-top", string(value))
+}
+
+// PaddingRight adds a padding-right property
+func (s *Style) PaddingRight(value Length) *Style {
+	return s.add("padding-right", string(value))
+}
+
+// PaddingBottom adds a padding-bottom property
+func (s *Style) PaddingBottom(value Length) *Style {
+	return s.add("padding-bottom", string(value))
+}
+
+// PaddingLeft adds a padding-left property
+func (s *Style) PaddingLeft(value Length) *Style {
+	return s.add("padding-left", string(value))
+}
+
+// PaddingInline adds a padding-inline property
+func (s *Style) PaddingInline(value Length) *Style {
+	return s.add("padding-inline", string(value))
+}
+
+// PaddingInlineStart adds a padding-inline-start property
+func (s *Style) PaddingInlineStart(value Length) *Style {
+	return s.add("padding-inline-start", string(value))
+}
+
+// PaddingInlineEnd adds a padding-inline-end property
+func (s *Style) PaddingInlineEnd(value Length) *Style {
+	return s.add("padding-inline-end", string(value))
+}
+
+// PaddingBlock adds a padding-block property
+func (s *Style) PaddingBlock(value Length) *Style {
+	return s.add("padding-block", string(value))
+}
+
+// PaddingBlockStart adds a padding-block-start property
+func (s *Style) PaddingBlockStart(value Length) *Style {
+	return s.add("padding-block-start", string(value))
+}
+
+// PaddingBlockEnd adds a padding-block-end property
+func (s *Style) PaddingBlockEnd(value Length) *Style {
+	return s.add("padding-block-end", string(value))
+}
+
+// Margin adds a margin property
+func (s *Style) Margin(value Length) *Style {
+	return s.add("margin", string(value))
+}
+
+// MarginTop adds a margin-top property
+func (s *Style) MarginTop(value Length) *Style {
+	return s.add("margin-top", string(value))
+}
+
+// MarginRight adds a margin-right property
+func (s *Style) MarginRight(value Length) *Style {
+	return s.add("margin-right", string(value))
+}
+
+// MarginBottom adds a margin-bottom property
+func (s *Style) MarginBottom(value Length) *Style {
+	return s.add("margin-bottom", string(value))
+}
+
+// MarginLeft adds a margin-left property
+func (s *Style) MarginLeft(value Length) *Style {
+	return s.add("margin-left", string(value))
+}
+
+// MarginInline adds a margin-inline property
+func (s *Style) MarginInline(value Length) *Style {
+	return s.add("margin-inline", string(value))
+}
+
+// MarginInlineStart adds a margin-inline-start property
+func (s *Style) MarginInlineStart(value Length) *Style {
+	return s.add("margin-inline-start", string(value))
+}
+
+// MarginInlineEnd adds a margin-inline-end property
+func (s *Style) MarginInlineEnd(value Length) *Style {
+	return s.add("margin-inline-end", string(value))
+}
+
+// MarginBlock adds a margin-block property
+func (s *Style) MarginBlock(value Length) *Style {
+	return s.add("margin-block", string(value))
+}
+
+// MarginBlockStart adds a margin-block-start property
+func (s *Style) MarginBlockStart(value Length) *Style {
+	return s.add("margin-block-start", string(value))
+}
+
+// MarginBlockEnd adds a margin-block-end property
+func (s *Style) MarginBlockEnd(value Length) *Style {
+	return s.add("margin-block-end", string(value))
+}
+
+// Border adds a border property
+func (s *Style) Border(value string) *Style {
+	return s.add("border", value)
+}
+
+// BorderWidth adds a border-width property
+func (s *Style) BorderWidth(value Length) *Style {
+	return s.add("border-width", string(value))
+}
+
+// BorderStyle adds a border-style property
+func (s *Style) BorderStyle(value string) *Style {
+	return s.add("border-style", value)
+}
+
+// BorderColor adds a border-color property
+func (s *Style) BorderColor(value ColorValue) *Style {
+	return s.add("border-color", string(value))
+}
+
+// BorderTop adds a border-top property
+func (s *Style) BorderTop(value string) *Style {
+	return s.add("border-top", value)
+}
+
+// BorderRight adds a border-right property
+func (s *Style) BorderRight(value string) *Style {
+	return s.add("border-right", value)
+}
+
+// BorderBottom adds a border-bottom property
+func (s *Style) BorderBottom(value string) *Style {
+	return s.add("border-bottom", value)
+}
+
+// BorderLeft adds a border-left property
+func (s *Style) BorderLeft(value string) *Style {
+	return s.add("border-left", value)
+}
+
+// BorderInline adds a border-inline property
+func (s *Style) BorderInline(value string) *Style {
+	return s.add("border-inline", value)
+}
+
+// BorderInlineStart adds a border-inline-start property
+func (s *Style) BorderInlineStart(value string) *Style {
+	return s.add("border-inline-start", value)
+}
+
+// BorderInlineEnd adds a border-inline-end property
+func (s *Style) BorderInlineEnd(value string) *Style {
+	return s.add("border-inline-end", value)
+}
+
+// BorderBlock adds a border-block property
+func (s *Style) BorderBlock(value string) *Style {
+	return s.add("border-block", value)
+}
+
+// BorderBlockStart adds a border-block-start property
+func (s *Style) BorderBlockStart(value string) *Style {
+	return s.add("border-block-start", value)
+}
+
+// BorderBlockEnd adds a border-block-end property
+func (s *Style) BorderBlockEnd(value string) *Style {
+	return s.add("border-block-end", value)
+}
+
+// BorderRadius adds a border-radius property
+func (s *Style) BorderRadius(value Length) *Style {
+	return s.add("border-radius", string(value))
+}
+
+// BorderTopLeftRadius adds a border-top-left-radius property
+func (s *Style) BorderTopLeftRadius(value Length) *Style {
+	return s.add("border-top-left-radius", string(value))
+}
+
+// BorderTopRightRadius adds a border-top-right-radius property
+func (s *Style) BorderTopRightRadius(value Length) *Style {
+	return s.add("border-top-right-radius", string(value))
+}
+
+// BorderBottomRightRadius adds a border-bottom-right-radius property
+func (s *Style) BorderBottomRightRadius(value Length) *Style {
+	return s.add("border-bottom-right-radius", string(value))
+}
+
+// BorderBottomLeftRadius adds a border-bottom-left-radius property
+func (s *Style) BorderBottomLeftRadius(value Length) *Style {
+	return s.add("border-bottom-left-radius", string(value))
+}
+
+// BorderStartStartRadius adds a border-start-start-radius property
+func (s *Style) BorderStartStartRadius(value Length) *Style {
+	return s.add("border-start-start-radius", string(value))
+}
+
+// BorderStartEndRadius adds a border-start-end-radius property
+func (s *Style) BorderStartEndRadius(value Length) *Style {
+	return s.add("border-start-end-radius", string(value))
+}
+
+// BorderEndStartRadius adds a border-end-start-radius property
+func (s *Style) BorderEndStartRadius(value Length) *Style {
+	return s.add("border-end-start-radius", string(value))
+}
+
+// BorderEndEndRadius adds a border-end-end-radius property
+func (s *Style) BorderEndEndRadius(value Length) *Style {
+	return s.add("border-end-end-radius", string(value))
+}
+
+// BorderCollapse adds a border-collapse property
+func (s *Style) BorderCollapse(value string) *Style {
+	return s.add("border-collapse", value)
+}
+
+// BorderSpacing adds a border-spacing property
+func (s *Style) BorderSpacing(value Length) *Style {
+	return s.add("border-spacing", string(value))
+}
+
+// Outline adds a outline property
+func (s *Style) Outline(value string) *Style {
+	return s.add("outline", value)
+}
+
+// OutlineWidth adds a outline-width property
+func (s *Style) OutlineWidth(value Length) *Style {
+	return s.add("outline-width", string(value))
+}
+
+// OutlineStyle adds a outline-style property
+func (s *Style) OutlineStyle(value string) *Style {
+	return s.add("outline-style", value)
+}
+
+// OutlineColor adds a outline-color property
+func (s *Style) OutlineColor(value ColorValue) *Style {
+	return s.add("outline-color", string(value))
+}
+
+// OutlineOffset adds a outline-offset property
+func (s *Style) OutlineOffset(value Length) *Style {
+	return s.add("outline-offset", string(value))
+}
+
+// Overflow adds a overflow property
+func (s *Style) Overflow(value string) *Style {
+	return s.add("overflow", value)
+}
+
+// OverflowX adds a overflow-x property
+func (s *Style) OverflowX(value string) *Style {
+	return s.add("overflow-x", value)
+}
+
+// OverflowY adds a overflow-y property
+func (s *Style) OverflowY(value string) *Style {
+	return s.add("overflow-y", value)
+}
+
+// OverflowWrap adds a overflow-wrap property
+func (s *Style) OverflowWrap(value string) *Style {
+	return s.add("overflow-wrap", value)
+}
+
+// OverscrollBehavior adds a overscroll-behavior property
+func (s *Style) OverscrollBehavior(value string) *Style {
+	return s.add("overscroll-behavior", value)
+}
+
+// ScrollBehavior adds a scroll-behavior property
+func (s *Style) ScrollBehavior(value string) *Style {
+	return s.add("scroll-behavior", value)
+}
+
+// ScrollbarGutter adds a scrollbar-gutter property
+func (s *Style) ScrollbarGutter(value string) *Style {
+	return s.add("scrollbar-gutter", value)
+}
+
+// ScrollMargin adds a scroll-margin property
+func (s *Style) ScrollMargin(value Length) *Style {
+	return s.add("scroll-margin", string(value))
+}
+
+// ScrollPadding adds a scroll-padding property
+func (s *Style) ScrollPadding(value Length) *Style {
+	return s.add("scroll-padding", string(value))
+}
+
+// ScrollSnapType adds a scroll-snap-type property
+func (s *Style) ScrollSnapType(value string) *Style {
+	return s.add("scroll-snap-type", value)
+}
+
+// ScrollSnapAlign adds a scroll-snap-align property
+func (s *Style) ScrollSnapAlign(value string) *Style {
+	return s.add("scroll-snap-align", value)
+}
+
+// Font adds a font property
+func (s *Style) Font(value string) *Style {
+	return s.add("font", value)
+}
+
+// FontFamily adds a font-family property
+func (s *Style) FontFamily(value FontFamily) *Style {
+	return s.add("font-family", string(value))
+}
+
+// FontSize adds a font-size property
+func (s *Style) FontSize(value Length) *Style {
+	return s.add("font-size", string(value))
+}
+
+// FontWeight adds a font-weight property
+func (s *Style) FontWeight(value FontWeight) *Style {
+	return s.add("font-weight", string(value))
+}
+
+// FontStyle adds a font-style property
+func (s *Style) FontStyle(value string) *Style {
+	return s.add("font-style", value)
+}
+
+// LineHeight adds a line-height property
+func (s *Style) LineHeight(value Number) *Style {
+	return s.add("line-height", string(value))
+}
+
+// LetterSpacing adds a letter-spacing property
+func (s *Style) LetterSpacing(value Length) *Style {
+	return s.add("letter-spacing", string(value))
+}
+
+// TextAlign adds a text-align property
+func (s *Style) TextAlign(value string) *Style {
+	return s.add("text-align", value)
+}
+
+// TextDecoration adds a text-decoration property
+func (s *Style) TextDecoration(value string) *Style {
+	return s.add("text-decoration", value)
+}
+
+// TextDecorationColor adds a text-decoration-color property
+func (s *Style) TextDecorationColor(value ColorValue) *Style {
+	return s.add("text-decoration-color", string(value))
+}
+
+// TextTransform adds a text-transform property
+func (s *Style) TextTransform(value string) *Style {
+	return s.add("text-transform", value)
+}
+
+// TextOverflow adds a text-overflow property
+func (s *Style) TextOverflow(value string) *Style {
+	return s.add("text-overflow", value)
+}
+
+// TextWrap adds a text-wrap property
+func (s *Style) TextWrap(value string) *Style {
+	return s.add("text-wrap", value)
+}
+
+// TextIndent adds a text-indent property
+func (s *Style) TextIndent(value Length) *Style {
+	return s.add("text-indent", string(value))
+}
+
+// TextShadow adds a text-shadow property
+func (s *Style) TextShadow(value string) *Style {
+	return s.add("text-shadow", value)
+}
+
+// WhiteSpace adds a white-space property
+func (s *Style) WhiteSpace(value string) *Style {
+	return s.add("white-space", value)
+}
+
+// WordBreak adds a word-break property
+func (s *Style) WordBreak(value string) *Style {
+	return s.add("word-break", value)
+}
+
+// VerticalAlign adds a vertical-align property
+func (s *Style) VerticalAlign(value string) *Style {
+	return s.add("vertical-align", value)
+}
+
+// Hyphens adds a hyphens property
+func (s *Style) Hyphens(value string) *Style {
+	return s.add("hyphens", value)
+}
+
+// ListStyle adds a list-style property
+func (s *Style) ListStyle(value string) *Style {
+	return s.add("list-style", value)
+}
+
+// Content adds a content property
+func (s *Style) Content(value string) *Style {
+	return s.add("content", value)
+}
+
+// BoxShadow adds a box-shadow property
+func (s *Style) BoxShadow(value ShadowValue) *Style {
+	return s.add("box-shadow", string(value))
+}
+
+// Filter adds a filter property
+func (s *Style) Filter(value string) *Style {
+	return s.add("filter", value)
+}
+
+// BackdropFilter adds a backdrop-filter property
+func (s *Style) BackdropFilter(value string) *Style {
+	return s.add("backdrop-filter", value)
+}
+
+// MixBlendMode adds a mix-blend-mode property
+func (s *Style) MixBlendMode(value string) *Style {
+	return s.add("mix-blend-mode", value)
+}
+
+// Transform adds a transform property
+func (s *Style) Transform(value string) *Style {
+	return s.add("transform", value)
+}
+
+// TransformOrigin adds a transform-origin property
+func (s *Style) TransformOrigin(value string) *Style {
+	return s.add("transform-origin", value)
+}
+
+// Translate adds a translate property
+func (s *Style) Translate(value string) *Style {
+	return s.add("translate", value)
+}
+
+// Rotate adds a rotate property
+func (s *Style) Rotate(value string) *Style {
+	return s.add("rotate", value)
+}
+
+// Scale adds a scale property
+func (s *Style) Scale(value string) *Style {
+	return s.add("scale", value)
+}
+
+// ClipPath adds a clip-path property
+func (s *Style) ClipPath(value string) *Style {
+	return s.add("clip-path", value)
+}
+
+// WillChange adds a will-change property
+func (s *Style) WillChange(value string) *Style {
+	return s.add("will-change", value)
+}
+
+// Cursor adds a cursor property
+func (s *Style) Cursor(value string) *Style {
+	return s.add("cursor", value)
+}
+
+// PointerEvents adds a pointer-events property
+func (s *Style) PointerEvents(value string) *Style {
+	return s.add("pointer-events", value)
+}
+
+// UserSelect adds a user-select property
+func (s *Style) UserSelect(value string) *Style {
+	return s.add("user-select", value)
+}
+
+// TouchAction adds a touch-action property
+func (s *Style) TouchAction(value string) *Style {
+	return s.add("touch-action", value)
+}
+
+// Resize adds a resize property
+func (s *Style) Resize(value string) *Style {
+	return s.add("resize", value)
+}
+
+// Appearance adds a appearance property
+func (s *Style) Appearance(value string) *Style {
+	return s.add("appearance", value)
+}
+
+// Transition adds a transition property
+func (s *Style) Transition(value string) *Style {
+	return s.add("transition", value)
+}
+
+// TransitionProperty adds a transition-property property
+func (s *Style) TransitionProperty(value string) *Style {
+	return s.add("transition-property", value)
+}
+
+// TransitionDuration adds a transition-duration property
+func (s *Style) TransitionDuration(value Duration) *Style {
+	return s.add("transition-duration", string(value))
+}
+
+// TransitionTimingFunction adds a transition-timing-function property
+func (s *Style) TransitionTimingFunction(value Easing) *Style {
+	return s.add("transition-timing-function", string(value))
+}
+
+// TransitionDelay adds a transition-delay property
+func (s *Style) TransitionDelay(value Duration) *Style {
+	return s.add("transition-delay", string(value))
+}
+
+// Animation adds a animation property
+func (s *Style) Animation(value AnimationValue) *Style {
+	return s.add("animation", string(value))
+}
+
+// AnimationName adds a animation-name property
+func (s *Style) AnimationName(value string) *Style {
+	return s.add("animation-name", value)
+}
+
+// AnimationDuration adds a animation-duration property
+func (s *Style) AnimationDuration(value Duration) *Style {
+	return s.add("animation-duration", string(value))
+}
+
+// AnimationTimingFunction adds a animation-timing-function property
+func (s *Style) AnimationTimingFunction(value Easing) *Style {
+	return s.add("animation-timing-function", string(value))
+}
+
+// AnimationDelay adds a animation-delay property
+func (s *Style) AnimationDelay(value Duration) *Style {
+	return s.add("animation-delay", string(value))
+}
+
+// AnimationIterationCount adds a animation-iteration-count property
+func (s *Style) AnimationIterationCount(value string) *Style {
+	return s.add("animation-iteration-count", value)
+}
+
+// AnimationDirection adds a animation-direction property
+func (s *Style) AnimationDirection(value string) *Style {
+	return s.add("animation-direction", value)
+}
+
+// AnimationFillMode adds a animation-fill-mode property
+func (s *Style) AnimationFillMode(value string) *Style {
+	return s.add("animation-fill-mode", value)
+}
+
+// AnimationPlayState adds a animation-play-state property
+func (s *Style) AnimationPlayState(value string) *Style {
+	return s.add("animation-play-state", value)
+}
+
+// Container adds a container property
+func (s *Style) Container(value string) *Style {
+	return s.add("container", value)
+}
+
+// ContainerType adds a container-type property
+func (s *Style) ContainerType(value string) *Style {
+	return s.add("container-type", value)
+}
+
+// ContainerName adds a container-name property
+func (s *Style) ContainerName(value string) *Style {
+	return s.add("container-name", value)
+}
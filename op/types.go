@@ -0,0 +1,105 @@
+package op
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Typed token values. Every accessor returns one of these types, and the
+// Style builder only accepts the type that fits the property, so passing a
+// color to Padding fails to compile. Literal CSS still works through untyped
+// string constants (Padding("0 auto")) or an explicit conversion
+// (op.Length(value)), except for Background, whose literals go through Bg;
+// Style.Custom takes a raw value, which is validated.
+
+// Length is a CSS length: sizes, spacing, radii, border widths and font sizes
+type Length string
+
+// ColorValue is a CSS color
+type ColorValue string
+
+// ShadowValue is a box-shadow value
+type ShadowValue string
+
+// GradientValue is a CSS gradient image
+type GradientValue string
+
+// BackgroundValue is a value of the background shorthand: a ColorValue, a
+// GradientValue or a literal from Bg. Being an interface, it does not accept
+// untyped string constants, so Background("red") does not compile.
+type BackgroundValue interface {
+	fmt.Stringer
+	background()
+}
+
+// backgroundLiteral is literal background CSS returned by Bg
+type backgroundLiteral string
+
+func (ColorValue) background()        {}
+func (GradientValue) background()     {}
+func (backgroundLiteral) background() {}
+
+// Bg returns literal CSS for Style.Background, e.g.
+// Background(op.Bg("transparent")) or Background(op.Bg("url(hero.jpg) center / cover"))
+func Bg(css string) BackgroundValue {
+	return backgroundLiteral(css)
+}
+
+// Easing is a transition or animation timing function
+type Easing string
+
+// Duration is a CSS time value
+type Duration string
+
+// AnimationValue is an animation shorthand value
+type AnimationValue string
+
+// FontWeight is a font-weight value
+type FontWeight string
+
+// FontFamily is a font-family stack
+type FontFamily string
+
+// Number is a unitless value: line heights, z-index layers and aspect ratios
+type Number string
+
+func (v Length) String() string         { return string(v) }
+func (v ColorValue) String() string     { return string(v) }
+func (v ShadowValue) String() string    { return string(v) }
+func (v GradientValue) String() string  { return string(v) }
+func (v Easing) String() string         { return string(v) }
+func (v Duration) String() string       { return string(v) }
+func (v AnimationValue) String() string { return string(v) }
+func (v FontWeight) String() string     { return string(v) }
+func (v FontFamily) String() string     { return string(v) }
+func (v Number) String() string         { return string(v) }
+
+func (v backgroundLiteral) String() string { return string(v) }
+
+// stringOf returns the CSS of an interface value, or "" for nil
+func stringOf(v fmt.Stringer) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
+
+// Px returns a pixel length
+func Px(n int) Length {
+	return Length(strconv.Itoa(n) + "px")
+}
+
+// Rem returns a rem length
+func Rem(n float64) Length {
+	return Length(strconv.FormatFloat(n, 'f', -1, 64) + "rem")
+}
+
+// Percent returns a percentage length
+func Percent(n float64) Length {
+	return Length(strconv.FormatFloat(n, 'f', -1, 64) + "%")
+}
+
+// Ms returns a duration in milliseconds
+func Ms(n int) Duration {
+	return Duration(fmt.Sprintf("%dms", n))
+}
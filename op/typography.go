@@ -8,120 +8,120 @@ var Font = &font{}
 type font struct{}
 
 // Size returns a font size variable (--font-size-{0-8})
-func (f *font) Size(n int) string {
+func (f *font) Size(n int) Length {
 	if n < 0 || n > 8 {
 		n = clamp(n, 0, 8)
 	}
-	return cssVar(fmt.Sprintf("font-size-%d", n))
+	return Length(cssVar(fmt.Sprintf("font-size-%d", n)))
 }
 
 // SizeFluid returns a fluid font size variable (--font-size-fluid-{0-3})
-func (f *font) SizeFluid(n int) string {
+func (f *font) SizeFluid(n int) Length {
 	if n < 0 || n > 3 {
 		n = clamp(n, 0, 3)
 	}
-	return cssVar(fmt.Sprintf("font-size-fluid-%d", n))
+	return Length(cssVar(fmt.Sprintf("font-size-fluid-%d", n)))
 }
 
 // Weight returns a font weight variable (--font-weight-{1-9})
-func (f *font) Weight(n int) string {
+func (f *font) Weight(n int) FontWeight {
 	if n < 1 || n > 9 {
 		n = clamp(n, 1, 9)
 	}
-	return cssVar(fmt.Sprintf("font-weight-%d", n))
+	return FontWeight(cssVar(fmt.Sprintf("font-weight-%d", n)))
 }
 
 // LineHeight returns a line height variable (--font-lineheight-{0-5})
-func (f *font) LineHeight(n int) string {
+func (f *font) LineHeight(n int) Number {
 	if n == 0 {
-		return cssVar("font-lineheight-00")
+		return Number(cssVar("font-lineheight-00"))
 	}
 	if n < 0 || n > 5 {
 		n = clamp(n, 0, 5)
 	}
-	return cssVar(fmt.Sprintf("font-lineheight-%d", n))
+	return Number(cssVar(fmt.Sprintf("font-lineheight-%d", n)))
 }
 
 // LetterSpacing returns a letter spacing variable (--font-letterspacing-{0-7})
-func (f *font) LetterSpacing(n int) string {
+func (f *font) LetterSpacing(n int) Length {
 	if n < 0 || n > 7 {
 		n = clamp(n, 0, 7)
 	}
-	return cssVar(fmt.Sprintf("font-letterspacing-%d", n))
+	return Length(cssVar(fmt.Sprintf("font-letterspacing-%d", n)))
 }
 
 // Font family helpers
-func (f *font) Sans() string {
-	return cssVar("font-sans")
+func (f *font) Sans() FontFamily {
+	return FontFamily(cssVar("font-sans"))
 }
 
-func (f *font) Serif() string {
-	return cssVar("font-serif")
+func (f *font) Serif() FontFamily {
+	return FontFamily(cssVar("font-serif"))
 }
 
-func (f *font) Mono() string {
-	return cssVar("font-mono")
+func (f *font) Mono() FontFamily {
+	return FontFamily(cssVar("font-mono"))
 }
 
 // Specific font family variables
-func (f *font) SystemUI() string {
-	return cssVar("font-system-ui")
+func (f *font) SystemUI() FontFamily {
+	return FontFamily(cssVar("font-system-ui"))
 }
 
-func (f *font) Transitional() string {
-	return cssVar("font-transitional")
+func (f *font) Transitional() FontFamily {
+	return FontFamily(cssVar("font-transitional"))
 }
 
-func (f *font) OldStyle() string {
-	return cssVar("font-old-style")
+func (f *font) OldStyle() FontFamily {
+	return FontFamily(cssVar("font-old-style"))
 }
 
-func (f *font) Humanist() string {
-	return cssVar("font-humanist")
+func (f *font) Humanist() FontFamily {
+	return FontFamily(cssVar("font-humanist"))
 }
 
-func (f *font) GeometricHumanist() string {
-	return cssVar("font-geometric-humanist")
+func (f *font) GeometricHumanist() FontFamily {
+	return FontFamily(cssVar("font-geometric-humanist"))
 }
 
-func (f *font) ClassicalHumanist() string {
-	return cssVar("font-classical-humanist")
+func (f *font) ClassicalHumanist() FontFamily {
+	return FontFamily(cssVar("font-classical-humanist"))
 }
 
-func (f *font) NeoGrotesque() string {
-	return cssVar("font-neo-grotesque")
+func (f *font) NeoGrotesque() FontFamily {
+	return FontFamily(cssVar("font-neo-grotesque"))
 }
 
-func (f *font) MonospaceSlab() string {
-	return cssVar("font-monospace-slab-serif")
+func (f *font) MonospaceSlab() FontFamily {
+	return FontFamily(cssVar("font-monospace-slab-serif"))
 }
 
-func (f *font) MonospaceCode() string {
-	return cssVar("font-monospace-code")
+func (f *font) MonospaceCode() FontFamily {
+	return FontFamily(cssVar("font-monospace-code"))
 }
 
-func (f *font) Industrial() string {
-	return cssVar("font-industrial")
+func (f *font) Industrial() FontFamily {
+	return FontFamily(cssVar("font-industrial"))
 }
 
-func (f *font) RoundedSans() string {
-	return cssVar("font-rounded-sans")
+func (f *font) RoundedSans() FontFamily {
+	return FontFamily(cssVar("font-rounded-sans"))
 }
 
-func (f *font) SlabSerif() string {
-	return cssVar("font-slab-serif")
+func (f *font) SlabSerif() FontFamily {
+	return FontFamily(cssVar("font-slab-serif"))
 }
 
-func (f *font) Antique() string {
-	return cssVar("font-antique")
+func (f *font) Antique() FontFamily {
+	return FontFamily(cssVar("font-antique"))
 }
 
-func (f *font) Didone() string {
-	return cssVar("font-didone")
+func (f *font) Didone() FontFamily {
+	return FontFamily(cssVar("font-didone"))
 }
 
-func (f *font) Handwritten() string {
-	return cssVar("font-handwritten")
+func (f *font) Handwritten() FontFamily {
+	return FontFamily(cssVar("font-handwritten"))
 }
 
 // Font size constants for common sizes
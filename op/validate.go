@@ -0,0 +1,136 @@
+package op
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+)
+
+// Problem is an accessor whose variable is not defined by a stylesheet
+type Problem struct {
+	Accessor string // Go expression, e.g. "op.SizePx(15)"
+	Variable string // Custom property it references, e.g. "--size-px-15"
+}
+
+// String formats the problem for test and log output
+func (p Problem) String() string {
+	return fmt.Sprintf("%s references undefined %s", p.Accessor, p.Variable)
+}
+
+// accessorArgs covers every valid argument of the int accessors; values
+// outside a range are clamped, so the extremes repeat the boundary tokens
+var accessorArgs = [2]int{-3, 31}
+
+// packageFuncs are the package-level accessors taking a scale value or no
+// argument, called by reflection like the methods of accessorGroups
+var packageFuncs = []struct {
+	name string
+	fn   any
+}{
+	{"Size", Size},
+	{"SizePx", SizePx},
+	{"SizeFluid", SizeFluid},
+	{"SizeContent", SizeContent},
+	{"SizeHeader", SizeHeader},
+	{"Shadow", Shadow},
+	{"InnerShadow", InnerShadow},
+	{"Gradient", Gradient},
+	{"Layer", Layer},
+	{"LayerImportant", LayerImportant},
+	{"Radius", Radius},
+	{"RadiusRound", RadiusRound},
+	{"RadiusBlob", RadiusBlob},
+	{"RadiusConditional", RadiusConditional},
+}
+
+// ratioNames are the names accepted by Ratio
+var ratioNames = []string{"square", "landscape", "portrait", "widescreen", "ultrawide", "golden"}
+
+// accessorGroups are the accessor singletons, enumerated by reflection so
+// new methods are validated without being listed here
+var accessorGroups = []struct {
+	name  string
+	value any
+}{
+	{"Color", Color},
+	{"Font", Font},
+	{"Border", Border},
+	{"Animation", Animation},
+	{"Ease", Ease},
+}
+
+// customPropertyPattern matches custom property declarations
+var customPropertyPattern = regexp.MustCompile(`(?:^|[{;\s])(--[A-Za-z0-9_-]+)\s*:`)
+
+// commentPattern matches CSS comments
+var commentPattern = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+// CustomProperties returns the set of custom properties declared in css,
+// including the leading "--"
+func CustomProperties(css string) map[string]bool {
+	props := make(map[string]bool)
+	css = commentPattern.ReplaceAllString(css, "")
+	for _, m := range customPropertyPattern.FindAllStringSubmatch(css, -1) {
+		props[m[1]] = true
+	}
+	return props
+}
+
+// Validate calls every accessor of the package with every valid argument and
+// reports the variables that css does not declare, sorted by accessor:
+//
+//	for _, p := range op.Validate(uicss.CSS()) {
+//		log.Println(p)
+//	}
+func Validate(css string) []Problem {
+	defined := CustomProperties(css)
+	seen := make(map[string]bool)
+	var problems []Problem
+
+	check := func(accessor, value string) {
+		variable := "--" + tokenName(value)
+		if seen[variable] {
+			return
+		}
+		seen[variable] = true
+		if !defined[variable] {
+			problems = append(problems, Problem{Accessor: "op." + accessor, Variable: variable})
+		}
+	}
+
+	// call invokes an accessor with no argument or with every scale value
+	call := func(name string, fn reflect.Value) {
+		t := fn.Type()
+		if t.NumOut() != 1 || t.Out(0).Kind() != reflect.String {
+			return
+		}
+		switch {
+		case t.NumIn() == 0:
+			check(name+"()", fn.Call(nil)[0].String())
+		case t.NumIn() == 1 && t.In(0).Kind() == reflect.Int:
+			for n := accessorArgs[0]; n <= accessorArgs[1]; n++ {
+				out := fn.Call([]reflect.Value{reflect.ValueOf(n)})[0].String()
+				check(fmt.Sprintf("%s(%d)", name, n), out)
+			}
+		}
+	}
+
+	for _, f := range packageFuncs {
+		call(f.name, reflect.ValueOf(f.fn))
+	}
+	for _, name := range ratioNames {
+		check(fmt.Sprintf("Ratio(%q)", name), string(Ratio(name)))
+	}
+	for _, g := range accessorGroups {
+		v := reflect.ValueOf(g.value)
+		for i := 0; i < v.NumMethod(); i++ {
+			call(g.name+"."+v.Type().Method(i).Name, v.Method(i))
+		}
+	}
+
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].Accessor < problems[j].Accessor
+	})
+	return problems
+}
@@ -0,0 +1,143 @@
+package uicss
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AssetPrefix is the URL prefix used by the fingerprinted asset paths
+const AssetPrefix = "/static/"
+
+// asset is an embedded file served under a content-hashed name
+type asset struct {
+	name        string // base name, e.g. "dashboard"
+	ext         string // extension including the dot, e.g. ".css"
+	contentType string
+	body        []byte
+	hash        string
+
+	compressOnce sync.Once
+	gzipBody     []byte
+	brotliBody   []byte
+}
+
+// newAsset creates an asset and computes its content hash
+func newAsset(name, ext, contentType, body string) *asset {
+	sum := sha256.Sum256([]byte(body))
+	return &asset{
+		name:        name,
+		ext:         ext,
+		contentType: contentType,
+		body:        []byte(body),
+		hash:        hex.EncodeToString(sum[:8]),
+	}
+}
+
+// fileName returns the fingerprinted file name, e.g. "dashboard.1a2b3c4d5e6f7a8b.css"
+func (a *asset) fileName() string {
+	return a.name + "." + a.hash + a.ext
+}
+
+// matches reports whether file looks like a fingerprinted name of this asset
+// with any hash, e.g. a stale URL from a previous deploy
+func (a *asset) matches(file string) bool {
+	if !strings.HasPrefix(file, a.name+".") || !strings.HasSuffix(file, a.ext) {
+		return false
+	}
+	hash := strings.TrimSuffix(strings.TrimPrefix(file, a.name+"."), a.ext)
+	return isHash(hash)
+}
+
+// etag returns a strong ETag derived from the asset content
+func (a *asset) etag() string {
+	return `"` + a.hash + `"`
+}
+
+// serve writes the asset through http.ServeContent, which takes care of
+// conditional requests, HEAD and Range. The body is gzip or brotli encoded
+// when the client accepts it. No Last-Modified is sent: a build or process
+// time would differ between replicas serving the same content, so
+// revalidation relies on the content ETag alone.
+func (a *asset) serve(w http.ResponseWriter, r *http.Request, cacheControl string) {
+	w.Header().Set("Content-Type", a.contentType)
+	if cacheControl != "" {
+		w.Header().Set("Cache-Control", cacheControl)
+	}
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	body, etag := a.body, a.etag()
+	if encoding := negotiateEncoding(r.Header.Get("Accept-Encoding")); encoding != "" {
+		body = a.compressed(encoding)
+		// Each encoding is a distinct representation and needs its own strong ETag
+		etag = `"` + a.hash + "-" + encoding + `"`
+		w.Header().Set("Content-Encoding", encoding)
+	}
+	w.Header().Set("ETag", etag)
+	http.ServeContent(w, r, a.name+a.ext, time.Time{}, bytes.NewReader(body))
+}
+
+// isHash reports whether s is a lowercase hex string of the length we generate
+func isHash(s string) bool {
+	if len(s) != 16 {
+		return false
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	cssAsset         = newAsset("dashboard", ".css", "text/css", dashboardCSS)
+	readableCSSAsset = newAsset("dashboard.readable", ".css", "text/css", dashboardReadableCSS)
+	stylebookAsset   = newAsset("stylebook", ".html", "text/html", renderStylebook())
+
+	assets = []*asset{cssAsset, readableCSSAsset, stylebookAsset}
+)
+
+// CSSPath returns the fingerprinted URL of dashboard.css, e.g. "/static/dashboard.<hash>.css"
+func CSSPath() string {
+	return AssetPrefix + cssAsset.fileName()
+}
+
+// ReadableCSSPath returns the fingerprinted URL of dashboard.readable.css
+func ReadableCSSPath() string {
+	return AssetPrefix + readableCSSAsset.fileName()
+}
+
+// StylebookPath returns the fingerprinted URL of the stylebook
+func StylebookPath() string {
+	return AssetPrefix + stylebookAsset.fileName()
+}
+
+// AssetHandler returns an http.Handler that serves the fingerprinted assets
+// returned by CSSPath, ReadableCSSPath and StylebookPath with immutable caching.
+// Requests for an asset with a stale hash are redirected to the current URL;
+// anything else is answered with 404. Mount it on AssetPrefix:
+//
+//	http.Handle(uicss.AssetPrefix, uicss.AssetHandler())
+func AssetHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file := path.Base(r.URL.Path)
+		for _, a := range assets {
+			if file == a.fileName() {
+				a.serve(w, r, "public, max-age=31536000, immutable")
+				return
+			}
+			if a.matches(file) {
+				target := path.Join(path.Dir(r.URL.Path), a.fileName())
+				http.Redirect(w, r, target, http.StatusFound)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	})
+}
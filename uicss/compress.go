@@ -0,0 +1,83 @@
+package uicss
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// Supported content encodings, in order of preference
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+// compressed returns the asset body in the given encoding. The encodings are
+// computed once, on first use, and reused for every later request.
+func (a *asset) compressed(encoding string) []byte {
+	a.compressOnce.Do(func() {
+		a.gzipBody = gzipBytes(a.body)
+		a.brotliBody = brotliBytes(a.body)
+	})
+	switch encoding {
+	case encodingBrotli:
+		return a.brotliBody
+	case encodingGzip:
+		return a.gzipBody
+	default:
+		return a.body
+	}
+}
+
+// gzipBytes compresses data with gzip at the best compression level
+func gzipBytes(data []byte) []byte {
+	var buf bytes.Buffer
+	zw, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	zw.Write(data)
+	zw.Close()
+	return buf.Bytes()
+}
+
+// brotliBytes compresses data with brotli at the best compression level
+func brotliBytes(data []byte) []byte {
+	var buf bytes.Buffer
+	bw := brotli.NewWriterLevel(&buf, brotli.BestCompression)
+	bw.Write(data)
+	bw.Close()
+	return buf.Bytes()
+}
+
+// negotiateEncoding picks the preferred supported encoding from an
+// Accept-Encoding header, or "" when the identity encoding should be used
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := make(map[string]bool)
+	wildcard := false
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		if name == "*" {
+			wildcard = q > 0
+			continue
+		}
+		accepted[name] = q > 0
+	}
+
+	for _, encoding := range []string{encodingBrotli, encodingGzip} {
+		if ok, listed := accepted[encoding]; ok || (!listed && wildcard) {
+			return encoding
+		}
+	}
+	return ""
+}
@@ -0,0 +1,165 @@
+package uicss
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// ThemeColor holds the light and dark value of a semantic color token.
+// An empty side keeps the framework default for that theme.
+type ThemeColor struct {
+	Light string
+	Dark  string
+}
+
+// Same returns a ThemeColor that uses one value for both themes
+func Same(value string) ThemeColor {
+	return ThemeColor{Light: value, Dark: value}
+}
+
+// ThemeOptions lists the semantic tokens a theme can override.
+// Zero values keep the defaults from src/tokens.css.
+type ThemeOptions struct {
+	Background   ThemeColor // --background
+	Surface      ThemeColor // --surface
+	SurfaceAlt   ThemeColor // --surface-alt
+	Text         ThemeColor // --text
+	TextMuted    ThemeColor // --text-muted
+	Border       ThemeColor // --border
+	Primary      ThemeColor // --primary
+	PrimaryHover ThemeColor // --primary-hover
+	Shadow       ThemeColor // --shadow
+	ShadowLg     ThemeColor // --shadow-lg
+
+	FontSans string // --font-sans, with family names unquoted, e.g. Segoe UI, sans-serif
+	FontMono string // --font-mono
+	Radius   string // --radius, the corner radius of buttons, inputs and cards
+}
+
+// Theme is a generated stylesheet that overrides the semantic tokens.
+// Serve it after dashboard.css so its declarations take precedence.
+type Theme struct {
+	asset *asset
+}
+
+// defaultThemeColors holds the .light and .dark values from the embedded CSS
+var defaultThemeColors = parseThemeDefaults(dashboardCSS)
+
+// NewTheme generates an override stylesheet covering the :root light-dark()
+// values and the .light/.dark blocks. It returns an error if a value could
+// break out of its declaration.
+func NewTheme(opts ThemeOptions) (*Theme, error) {
+	colors := []struct {
+		name  string
+		color ThemeColor
+	}{
+		{"background", opts.Background},
+		{"surface", opts.Surface},
+		{"surface-alt", opts.SurfaceAlt},
+		{"text", opts.Text},
+		{"text-muted", opts.TextMuted},
+		{"border", opts.Border},
+		{"primary", opts.Primary},
+		{"primary-hover", opts.PrimaryHover},
+		{"shadow", opts.Shadow},
+		{"shadow-lg", opts.ShadowLg},
+	}
+	values := []struct {
+		name  string
+		value string
+	}{
+		{"font-sans", opts.FontSans},
+		{"font-mono", opts.FontMono},
+		{"radius", opts.Radius},
+	}
+
+	var root, light, dark strings.Builder
+	for _, c := range colors {
+		if c.color.Light == "" && c.color.Dark == "" {
+			continue
+		}
+		if err := validateThemeValue(c.name, c.color.Light); err != nil {
+			return nil, err
+		}
+		if err := validateThemeValue(c.name, c.color.Dark); err != nil {
+			return nil, err
+		}
+
+		lightValue, darkValue := c.color.Light, c.color.Dark
+		if lightValue == "" {
+			lightValue = defaultThemeColors["light"][c.name]
+		}
+		if darkValue == "" {
+			darkValue = defaultThemeColors["dark"][c.name]
+		}
+		fmt.Fprintf(&root, "  --%s: light-dark(%s, %s);\n", c.name, lightValue, darkValue)
+		fmt.Fprintf(&light, "  --%s: %s;\n", c.name, lightValue)
+		fmt.Fprintf(&dark, "  --%s: %s;\n", c.name, darkValue)
+	}
+	for _, v := range values {
+		if v.value == "" {
+			continue
+		}
+		if err := validateThemeValue(v.name, v.value); err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&root, "  --%s: %s;\n", v.name, v.value)
+	}
+
+	var css strings.Builder
+	if root.Len() > 0 {
+		css.WriteString(":root {\n" + root.String() + "}\n")
+	}
+	if light.Len() > 0 {
+		css.WriteString("\n.light {\n" + light.String() + "}\n")
+		css.WriteString("\n.dark {\n" + dark.String() + "}\n")
+	}
+
+	return &Theme{asset: newAsset("theme", ".css", "text/css", css.String())}, nil
+}
+
+// CSS returns the theme stylesheet as a string
+func (t *Theme) CSS() string {
+	return string(t.asset.body)
+}
+
+// Handler returns an http.Handler that serves the theme stylesheet
+func (t *Theme) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.asset.serve(w, r, "public, max-age=3600")
+	})
+}
+
+// validateThemeValue rejects values that could terminate the declaration
+// or the surrounding rule, including quotes and newlines that would open a
+// string or end the line
+func validateThemeValue(name, value string) error {
+	if strings.ContainsAny(value, ";{}<>\\\"'\r\n") || strings.Contains(value, "/*") {
+		return fmt.Errorf("uicss: invalid value %q for --%s", value, name)
+	}
+	return nil
+}
+
+// themeBlockPattern matches the minified .light and .dark theme blocks
+var themeBlockPattern = regexp.MustCompile(`\.(light|dark)\{([^}]*)\}`)
+
+// parseThemeDefaults extracts the custom properties of the .light and .dark
+// blocks, keyed by theme and property name without the leading dashes
+func parseThemeDefaults(css string) map[string]map[string]string {
+	defaults := map[string]map[string]string{
+		"light": {},
+		"dark":  {},
+	}
+	for _, m := range themeBlockPattern.FindAllStringSubmatch(css, -1) {
+		for _, decl := range strings.Split(m[2], ";") {
+			name, value, ok := strings.Cut(decl, ":")
+			if !ok || !strings.HasPrefix(name, "--") {
+				continue
+			}
+			defaults[m[1]][strings.TrimPrefix(name, "--")] = strings.TrimSpace(value)
+		}
+	}
+	return defaults
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 )
 
+//go:generate go run ../cmd/build-css -src ../src/index.css -vendor ../src/vendor -out dashboard.css -readable dashboard.readable.css
+
 //go:embed dashboard.css
 var dashboardCSS string
 
@@ -28,28 +30,25 @@ func ReadableCSS() string {
 // CSSHandler returns an http.Handler that serves the dashboard.css file
 func CSSHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/css")
-		w.Header().Set("Cache-Control", "public, max-age=3600")
-		w.Write([]byte(dashboardCSS))
+		cssAsset.serve(w, r, "public, max-age=3600")
 	})
 }
 
 // ReadableCSSHandler returns an http.Handler that serves the readable CSS file
 func ReadableCSSHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/css")
-		w.Header().Set("Cache-Control", "public, max-age=3600")
-		w.Write([]byte(dashboardReadableCSS))
+		readableCSSAsset.serve(w, r, "public, max-age=3600")
 	})
 }
 
 // StylebookHandler returns an http.Handler that serves the interactive stylebook
 func StylebookHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/html")
-		
-		// Inject the CSS inline into the stylebook HTML
-		html := strings.Replace(stylebookHTML, "<!-- CSS_PLACEHOLDER -->", "<style>"+dashboardCSS+"</style>", 1)
-		w.Write([]byte(html))
+		stylebookAsset.serve(w, r, "")
 	})
+}
+
+// renderStylebook injects the CSS inline into the stylebook HTML
+func renderStylebook() string {
+	return strings.Replace(stylebookHTML, "<!-- CSS_PLACEHOLDER -->", "<style>"+dashboardCSS+"</style>", 1)
 }
\ No newline at end of file
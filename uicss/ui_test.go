@@ -0,0 +1,197 @@
+package uicss
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAssetPaths(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		prefix string
+		suffix string
+	}{
+		{"CSSPath", CSSPath(), "/static/dashboard.", ".css"},
+		{"ReadableCSSPath", ReadableCSSPath(), "/static/dashboard.readable.", ".css"},
+		{"StylebookPath", StylebookPath(), "/static/stylebook.", ".html"},
+	}
+
+	for _, tt := range tests {
+		if !strings.HasPrefix(tt.path, tt.prefix) || !strings.HasSuffix(tt.path, tt.suffix) {
+			t.Errorf("%s() = %v, want %s<hash>%s", tt.name, tt.path, tt.prefix, tt.suffix)
+		}
+	}
+}
+
+func TestAssetHandler(t *testing.T) {
+	handler := AssetHandler()
+
+	// Current hash is served with immutable caching
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, CSSPath(), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET %s = %d, want %d", CSSPath(), rec.Code, http.StatusOK)
+	}
+	if cc := rec.Header().Get("Cache-Control"); !strings.Contains(cc, "immutable") {
+		t.Errorf("Cache-Control = %q, want immutable", cc)
+	}
+	if rec.Body.String() != CSS() {
+		t.Error("AssetHandler served unexpected body for CSSPath()")
+	}
+
+	// Stale hash redirects to the current URL
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/static/dashboard.0123456789abcdef.css", nil))
+	if rec.Code != http.StatusFound {
+		t.Fatalf("stale hash = %d, want %d", rec.Code, http.StatusFound)
+	}
+	if loc := rec.Header().Get("Location"); loc != CSSPath() {
+		t.Errorf("Location = %q, want %q", loc, CSSPath())
+	}
+
+	// Unknown files are not found
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/static/other.css", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("unknown file = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestConditionalGet(t *testing.T) {
+	handlers := map[string]http.Handler{
+		"CSSHandler":         CSSHandler(),
+		"ReadableCSSHandler": ReadableCSSHandler(),
+		"StylebookHandler":   StylebookHandler(),
+	}
+
+	for name, handler := range handlers {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		etag := rec.Header().Get("ETag")
+		if etag == "" || strings.HasPrefix(etag, "W/") {
+			t.Fatalf("%s: ETag = %q, want a strong ETag", name, etag)
+		}
+		if lm := rec.Header().Get("Last-Modified"); lm != "" {
+			t.Errorf("%s: Last-Modified = %q, want none", name, lm)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("If-None-Match", etag)
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusNotModified {
+			t.Errorf("%s: If-None-Match = %d, want %d", name, rec.Code, http.StatusNotModified)
+		}
+
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodHead, "/", nil))
+		if rec.Code != http.StatusOK || rec.Body.Len() != 0 {
+			t.Errorf("%s: HEAD = %d with %d body bytes, want 200 with none", name, rec.Code, rec.Body.Len())
+		}
+	}
+}
+
+func TestRangeRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Range", "bytes=0-9")
+	rec := httptest.NewRecorder()
+	CSSHandler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusPartialContent {
+		t.Fatalf("Range = %d, want %d", rec.Code, http.StatusPartialContent)
+	}
+	if rec.Body.String() != CSS()[:10] {
+		t.Errorf("Range body = %q, want %q", rec.Body.String(), CSS()[:10])
+	}
+}
+
+func TestCompression(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		expected       string
+	}{
+		{"", ""},
+		{"gzip", "gzip"},
+		{"gzip, deflate, br", "br"},
+		{"br;q=0, gzip", "gzip"},
+		{"identity", ""},
+		{"*", "br"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+		rec := httptest.NewRecorder()
+		CSSHandler().ServeHTTP(rec, req)
+
+		if enc := rec.Header().Get("Content-Encoding"); enc != tt.expected {
+			t.Errorf("Accept-Encoding %q: Content-Encoding = %q, want %q", tt.acceptEncoding, enc, tt.expected)
+		}
+		if vary := rec.Header().Get("Vary"); vary != "Accept-Encoding" {
+			t.Errorf("Accept-Encoding %q: Vary = %q, want Accept-Encoding", tt.acceptEncoding, vary)
+		}
+	}
+
+	// The gzip variant must decode back to the original CSS
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	ReadableCSSHandler().ServeHTTP(rec, req)
+
+	zr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("gzip.NewReader: %v", err)
+	}
+	decoded, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("reading gzip body: %v", err)
+	}
+	if string(decoded) != ReadableCSS() {
+		t.Error("gzip body does not decode to ReadableCSS()")
+	}
+}
+
+func TestNewTheme(t *testing.T) {
+	theme, err := NewTheme(ThemeOptions{
+		Primary: ThemeColor{Light: "#7c3aed", Dark: "#a78bfa"},
+		Surface: ThemeColor{Light: "#fafafa"},
+		Radius:  "12px",
+	})
+	if err != nil {
+		t.Fatalf("NewTheme() error = %v", err)
+	}
+
+	css := theme.CSS()
+	expectedParts := []string{
+		"--primary: light-dark(#7c3aed, #a78bfa);",
+		"--surface: light-dark(#fafafa, #0f0f10);", // dark side keeps the default
+		"--radius: 12px;",
+		".light {\n  --surface: #fafafa;\n  --primary: #7c3aed;\n}",
+		".dark {\n  --surface: #0f0f10;\n  --primary: #a78bfa;\n}",
+	}
+	for _, part := range expectedParts {
+		if !strings.Contains(css, part) {
+			t.Errorf("Theme.CSS() missing %q in:\n%s", part, css)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	theme.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Body.String() != css || rec.Header().Get("Content-Type") != "text/css" {
+		t.Error("Theme.Handler() did not serve the theme CSS")
+	}
+
+	if _, err := NewTheme(ThemeOptions{Primary: Same("red;} body{display:none")}); err == nil {
+		t.Error("NewTheme() accepted a value that breaks out of the declaration")
+	}
+	for _, font := range []string{`"Inter`, "Inter\n--text: red"} {
+		if _, err := NewTheme(ThemeOptions{FontSans: font}); err == nil {
+			t.Errorf("NewTheme() accepted font %q", font)
+		}
+	}
+}